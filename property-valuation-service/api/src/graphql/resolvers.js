@@ -3,7 +3,7 @@ const { logger } = require('../utils/logger');
 
 const resolvers = {
   Query: {
-    calculateValuation: async (_, { property }) => {
+    calculateValuation: async (_, { property }, { headers }) => {
       try {
         const result = await grpcClient.calculateValuation({
           address: property.address,
@@ -15,7 +15,7 @@ const resolvers = {
           condition: property.condition.toLowerCase(),
           maintenance_level: property.maintenanceLevel.toLowerCase(),
           renovation_status: property.renovationStatus.toLowerCase()
-        });
+        }, headers);
 
         return {
           value: result.value,
@@ -30,7 +30,7 @@ const resolvers = {
   },
 
   Mutation: {
-    calculateValuation: async (_, { property }) => {
+    calculateValuation: async (_, { property }, { headers }) => {
       try {
         const result = await grpcClient.calculateValuation({
           address: property.address,
@@ -42,7 +42,7 @@ const resolvers = {
           condition: property.condition.toLowerCase(),
           maintenance_level: property.maintenanceLevel.toLowerCase(),
           renovation_status: property.renovationStatus.toLowerCase()
-        });
+        }, headers);
 
         return {
           value: result.value,
@@ -34,7 +34,8 @@ const server = new ApolloServer({
   typeDefs,
   resolvers,
   context: ({ req }) => ({
-    // Add any context data here
+    // Forwarded so that valuations continue the caller's trace
+    headers: req.headers
   }),
   formatError: (error) => {
     logger.error('GraphQL Error:', error);
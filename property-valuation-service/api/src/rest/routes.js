@@ -71,7 +71,7 @@ router.post('/calculate', validateProperty, async (req, res) => {
       return res.status(400).json({ errors: errors.array() });
     }
 
-    const result = await grpcClient.calculateValuation(req.body);
+    const result = await grpcClient.calculateValuation(req.body, req.headers);
     res.json(result);
   } catch (error) {
     logger.error('REST API Error:', error);
@@ -1,4 +1,4 @@
-const { ValuationClient } = require('../../../node-client');
+const { ValuationClient, traceMetadata } = require('../../../node-client');
 const { logger } = require('./logger');
 
 class GrpcClientManager {
@@ -19,7 +19,13 @@ class GrpcClientManager {
     logger.info(`Connecting to gRPC server at ${serverAddress}`);
 
     try {
-      this.client = new ValuationClient(serverAddress);
+      // The API authenticates to the valuation service with its own key, if configured,
+      // and connects over TLS when a CA or client certificate is configured
+      const { GRPC_TLS_CA, GRPC_TLS_CERT, GRPC_TLS_KEY } = process.env;
+      const tls = GRPC_TLS_CA || GRPC_TLS_CERT
+        ? { ca: GRPC_TLS_CA, cert: GRPC_TLS_CERT, key: GRPC_TLS_KEY }
+        : undefined;
+      this.client = new ValuationClient(serverAddress, { apiKey: process.env.GRPC_API_KEY, tls });
       // Test the connection with a simple operation
       await this.testConnection();
       this.isConnected = true;
@@ -75,9 +81,10 @@ class GrpcClientManager {
     }
   }
 
-  async calculateValuation(property) {
+  // headers are those of the incoming request; their trace context is forwarded
+  async calculateValuation(property, headers) {
     return this.executeWithRetry(async (client) => {
-      return await client.calculateValuation(property);
+      return await client.calculateValuation(property, traceMetadata(headers));
     });
   }
 }
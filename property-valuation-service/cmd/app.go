@@ -0,0 +1,504 @@
+package main
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	pb "github.com/jsarcade/property-valuation-service/proto"
+	"github.com/jsarcade/property-valuation-service/pkg/approaches"
+	"github.com/jsarcade/property-valuation-service/pkg/audit"
+	"github.com/jsarcade/property-valuation-service/pkg/auth"
+	"github.com/jsarcade/property-valuation-service/pkg/comparables"
+	"github.com/jsarcade/property-valuation-service/pkg/history"
+	"github.com/jsarcade/property-valuation-service/pkg/location"
+	"github.com/jsarcade/property-valuation-service/pkg/logging"
+	"github.com/jsarcade/property-valuation-service/pkg/priceindex"
+	"github.com/jsarcade/property-valuation-service/pkg/pricing"
+	"github.com/jsarcade/property-valuation-service/pkg/ratelimit"
+	"github.com/jsarcade/property-valuation-service/pkg/tenant"
+	"github.com/jsarcade/property-valuation-service/pkg/tlsconfig"
+	"github.com/jsarcade/property-valuation-service/pkg/tracing"
+	"github.com/jsarcade/property-valuation-service/pkg/valuation"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// internalBufferSize is the buffer size of the in-memory connection between the
+// REST gateway and the gRPC service
+const internalBufferSize = 1 << 20
+
+// app represents a configured valuation service and the servers exposing it
+type app struct {
+	cfg    config
+	srv    *server
+	health *health.Server
+	logger *slog.Logger
+
+	// tls serves the reloadable certificate of the public listeners; nil when TLS is disabled
+	tls *tlsconfig.Reloader
+
+	// authenticator identifies the callers of every RPC; nil when authentication is disabled
+	authenticator *auth.Authenticator
+
+	// classifier classifies property locations by the configured zones; nil when none are
+	classifier *location.Classifier
+
+	// limiter enforces the rate limits and quotas of every client; nil when disabled
+	limiter *ratelimit.Limiter
+
+	// tracerProvider records the spans of every RPC; nil when tracing is disabled
+	tracerProvider trace.TracerProvider
+
+	grpcServer   *grpc.Server
+	grpcListener net.Listener
+
+	// The REST gateway reaches the service through an in-memory gRPC server so
+	// that it does not depend on the TLS settings of the public listener
+	internalServer   *grpc.Server
+	internalListener *bufconn.Listener
+	internalConn     *grpc.ClientConn
+	httpServer       *http.Server
+	httpListener     net.Listener
+
+	metricsServer   *http.Server
+	metricsListener net.Listener
+
+	closers []func() error
+}
+
+// newApp loads the data sets of the configuration and listens on its addresses
+func newApp(cfg config, logger *slog.Logger) (a *app, err error) {
+	a = &app{cfg: cfg, srv: newServer(cfg.Workers, cfg.MaxBatchSize), health: health.NewServer(), logger: logger}
+	a.srv.logger = logger
+	defer func() {
+		if err != nil {
+			a.close()
+		}
+	}()
+
+	if err := a.loadData(); err != nil {
+		return nil, err
+	}
+	if err := a.setupAuth(); err != nil {
+		return nil, err
+	}
+	if err := a.setupRateLimits(); err != nil {
+		return nil, err
+	}
+	if err := a.setupTracing(); err != nil {
+		return nil, err
+	}
+
+	options, err := a.serverOptions()
+	if err != nil {
+		return nil, err
+	}
+	a.grpcServer = a.newGRPCServer(options...)
+	a.grpcListener, err = net.Listen("tcp", cfg.GRPCAddr)
+	if err != nil {
+		return nil, fmt.Errorf("listening on %s: %w", cfg.GRPCAddr, err)
+	}
+	a.closers = append(a.closers, a.grpcListener.Close)
+
+	if cfg.HTTPAddr != "" {
+		if err := a.setupGateway(); err != nil {
+			return nil, err
+		}
+	}
+
+	if cfg.MetricsAddr != "" {
+		mux := http.NewServeMux()
+		mux.Handle("GET /metrics", a.srv.metrics.Handler())
+		a.metricsServer = &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+		a.metricsListener, err = net.Listen("tcp", cfg.MetricsAddr)
+		if err != nil {
+			return nil, fmt.Errorf("listening on %s: %w", cfg.MetricsAddr, err)
+		}
+		a.closers = append(a.closers, a.metricsListener.Close)
+	}
+
+	a.updateHealth()
+	return a, nil
+}
+
+// loadData loads the location zones, pricing model, tenant pricing, price indices,
+// sales, history and audit log configured for the service
+func (a *app) loadData() error {
+	cfg := a.cfg
+	if cfg.LocationZones != "" {
+		classifier, err := location.LoadClassifier(cfg.LocationZones)
+		if err != nil {
+			return fmt.Errorf("loading location zones: %w", err)
+		}
+		a.classifier = classifier
+	}
+
+	model := valuation.ActiveModel()
+	if cfg.PricingModel != "" {
+		var err error
+		model, err = pricing.LoadFile(cfg.PricingModel)
+		if err != nil {
+			return fmt.Errorf("loading pricing model: %w", err)
+		}
+		a.srv.reloadModel = a.reloadPricingModel
+		a.logger.Info("loaded pricing model", "version", model.Version, "path", cfg.PricingModel)
+	}
+	if _, err := a.activateModel(model); err != nil {
+		return fmt.Errorf("loading location zones: %w", err)
+	}
+
+	if cfg.TenantPricing != "" {
+		store, err := tenant.Open(cfg.TenantPricing, a.srv.clock)
+		if err != nil {
+			return fmt.Errorf("opening tenant pricing: %w", err)
+		}
+		a.closers = append(a.closers, store.Close)
+		a.srv.tenants = store
+		a.logger.Info("loaded tenant pricing", "path", cfg.TenantPricing)
+	}
+
+	if cfg.PriceIndices != "" {
+		indices, err := priceindex.Load(cfg.PriceIndices)
+		if err != nil {
+			return fmt.Errorf("loading price indices: %w", err)
+		}
+		valuation.PriceIndex = indices
+		a.logger.Info("loaded price indices", "regions", indices.Regions(), "path", cfg.PriceIndices)
+	}
+
+	if cfg.SalesData != "" {
+		sales, err := comparables.LoadSales(cfg.SalesData)
+		if err != nil {
+			return fmt.Errorf("loading sales dataset: %w", err)
+		}
+		engine := comparables.NewEngine(sales, comparables.DefaultOptions())
+		if err := a.srv.valuers.Register(approaches.SalesComparison{Engine: engine}); err != nil {
+			return fmt.Errorf("registering sales comparison approach: %w", err)
+		}
+		a.logger.Info("loaded recent sales", "sales", len(sales), "path", cfg.SalesData)
+	}
+
+	if cfg.HistoryDB != "" {
+		store, err := history.Open(cfg.HistoryDB)
+		if err != nil {
+			return fmt.Errorf("opening valuation history: %w", err)
+		}
+		a.closers = append(a.closers, store.Close)
+		a.srv.history = store
+		a.logger.Info("recording valuation history", "path", cfg.HistoryDB)
+	}
+
+	if cfg.AuditLog != "" {
+		auditLog, err := audit.Open(cfg.AuditLog, cfg.AuditRedact)
+		if err != nil {
+			return err
+		}
+		a.closers = append(a.closers, auditLog.Close)
+		a.srv.auditLog = auditLog
+		a.logger.Info("auditing valuation requests", "path", cfg.AuditLog, "redacted", cfg.AuditRedact)
+	}
+	return nil
+}
+
+// setupTracing creates the tracer provider exporting spans to the configured
+// OTLP collector and stdout, if any
+func (a *app) setupTracing() error {
+	opts := tracing.Options{OTLPEndpoint: a.cfg.OTLPEndpoint, OTLPInsecure: a.cfg.OTLPInsecure}
+	if a.cfg.TraceStdout {
+		opts.Stdout = os.Stdout
+	}
+	if !opts.Enabled() {
+		return nil
+	}
+
+	provider, err := tracing.NewProvider(context.Background(), opts)
+	if err != nil {
+		return err
+	}
+	a.tracerProvider = provider
+	a.closers = append(a.closers, func() error {
+		// Flush the spans of the last requests before exiting
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return provider.Shutdown(ctx)
+	})
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(tracing.Propagator)
+	a.logger.Info("tracing enabled", "otlp_endpoint", opts.OTLPEndpoint, "stdout", a.cfg.TraceStdout)
+	return nil
+}
+
+// serverOptions returns the options of the public gRPC server, loading the TLS
+// certificate and client CAs when TLS is enabled
+func (a *app) serverOptions() ([]grpc.ServerOption, error) {
+	if a.cfg.TLSCert == "" {
+		return nil, nil
+	}
+	reloader, err := tlsconfig.NewReloader(tlsconfig.Options{
+		CertFile:     a.cfg.TLSCert,
+		KeyFile:      a.cfg.TLSKey,
+		ClientCAFile: a.cfg.TLSClientCA,
+	})
+	if err != nil {
+		return nil, err
+	}
+	a.tls = reloader
+	return []grpc.ServerOption{grpc.Creds(credentials.NewTLS(reloader.Config()))}, nil
+}
+
+// newGRPCServer creates a gRPC server exposing the valuation, health and, when
+// enabled, reflection services. Both the public and the internal server observe
+// every RPC through the same interceptors, authorize it when authentication is
+// enabled, limit it per client when rate limits or quotas are configured and, when
+// tracing is enabled, continue the trace propagated by the caller. Metrics observe
+// RPCs before authentication and rate limits, so that rejected requests are counted.
+func (a *app) newGRPCServer(options ...grpc.ServerOption) *grpc.Server {
+	if a.tracerProvider != nil {
+		options = append(options, grpc.StatsHandler(otelgrpc.NewServerHandler(
+			otelgrpc.WithTracerProvider(a.tracerProvider),
+			otelgrpc.WithPropagators(tracing.Propagator),
+		)))
+	}
+	authUnary, authStream := a.authInterceptors()
+	limitUnary, limitStream := a.rateLimitInterceptors()
+	unary := append([]grpc.UnaryServerInterceptor{
+		logging.UnaryServerInterceptor(a.logger),
+		a.srv.metrics.UnaryServerInterceptor(),
+	}, authUnary...)
+	stream := append([]grpc.StreamServerInterceptor{
+		logging.StreamServerInterceptor(a.logger),
+		a.srv.metrics.StreamServerInterceptor(),
+	}, authStream...)
+	options = append(options,
+		grpc.ChainUnaryInterceptor(append(unary, limitUnary...)...),
+		grpc.ChainStreamInterceptor(append(stream, limitStream...)...),
+	)
+	s := grpc.NewServer(options...)
+	pb.RegisterValuationServiceServer(s, a.srv)
+	healthpb.RegisterHealthServer(s, a.health)
+	if a.cfg.Reflection {
+		reflection.Register(s)
+	}
+	return s
+}
+
+// setupGateway creates the REST gateway and the in-memory gRPC server it forwards to
+func (a *app) setupGateway() error {
+	a.internalServer = a.newGRPCServer()
+	a.internalListener = bufconn.Listen(internalBufferSize)
+	a.closers = append(a.closers, a.internalListener.Close)
+
+	conn, err := grpc.NewClient("passthrough:///internal",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return a.internalListener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return fmt.Errorf("connecting REST gateway: %w", err)
+	}
+	a.internalConn = conn
+	a.closers = append(a.closers, conn.Close)
+
+	gateway, err := newGateway(context.Background(), conn)
+	if err != nil {
+		return fmt.Errorf("creating REST gateway: %w", err)
+	}
+	a.httpServer = &http.Server{Handler: gateway, ReadHeaderTimeout: 10 * time.Second}
+	if a.tls != nil {
+		a.httpServer.TLSConfig = a.tls.Config()
+	}
+
+	a.httpListener, err = net.Listen("tcp", a.cfg.HTTPAddr)
+	if err != nil {
+		return fmt.Errorf("listening on %s: %w", a.cfg.HTTPAddr, err)
+	}
+	a.closers = append(a.closers, a.httpListener.Close)
+	return nil
+}
+
+// updateHealth reports the service as serving while the active pricing model is valid
+func (a *app) updateHealth() {
+	status := healthpb.HealthCheckResponse_SERVING
+	if model := valuation.ActiveModel(); model == nil || model.Validate() != nil {
+		status = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	a.health.SetServingStatus("", status)
+	a.health.SetServingStatus(pb.ValuationService_ServiceDesc.ServiceName, status)
+}
+
+// serve serves requests until ctx is done, then stops accepting new requests and
+// gives in-flight requests the drain timeout to finish
+func (a *app) serve(ctx context.Context) error {
+	defer a.close()
+
+	if a.cfg.PricingModel != "" {
+		go a.watchPricingModel(ctx)
+	}
+	if a.tls != nil {
+		go a.watchTLS(ctx)
+	}
+
+	errs := make(chan error, 4)
+	go func() { errs <- a.grpcServer.Serve(a.grpcListener) }()
+	a.logger.Info("gRPC server listening", "addr", a.grpcListener.Addr().String(), "tls", a.tls != nil, "mtls", a.tls != nil && a.tls.MutualTLS())
+	if a.httpServer != nil {
+		go func() { errs <- a.internalServer.Serve(a.internalListener) }()
+		go func() {
+			var err error
+			if a.tls != nil {
+				err = a.httpServer.ServeTLS(a.httpListener, "", "") // Certificates come from TLSConfig
+			} else {
+				err = a.httpServer.Serve(a.httpListener)
+			}
+			errs <- serveHTTP(err)
+		}()
+		a.logger.Info("REST gateway listening", "addr", a.httpListener.Addr().String(), "openapi", "/openapi.json")
+	}
+	if a.metricsServer != nil {
+		go func() { errs <- serveHTTP(a.metricsServer.Serve(a.metricsListener)) }()
+		a.logger.Info("metrics endpoint listening", "addr", a.metricsListener.Addr().String(), "path", "/metrics")
+	}
+
+	var err error
+	select {
+	case <-ctx.Done():
+	case err = <-errs:
+	}
+
+	a.shutdown()
+	return err
+}
+
+// activateModel makes a pricing model the one used for valuations, classifying
+// locations with the configured zones, and returns the model activated. Models
+// without a multiplier for every zone class are rejected and the previous one stays active.
+func (a *app) activateModel(model *valuation.PricingModel) (*valuation.PricingModel, error) {
+	if a.classifier != nil {
+		if err := a.classifier.Validate(model); err != nil {
+			return nil, err
+		}
+		model = model.WithClassifier(a.classifier)
+	}
+	valuation.SetActiveModel(model)
+	return model, nil
+}
+
+// watchPricingModel hot-reloads the pricing model file until ctx is done
+func (a *app) watchPricingModel(ctx context.Context) {
+	err := pricing.Watch(ctx, a.cfg.PricingModel,
+		func(model *valuation.PricingModel) {
+			if _, err := a.activateModel(model); err != nil {
+				a.logger.Error("pricing model reload failed", "kept_version", valuation.ActiveModel().Version, "error", err)
+				return
+			}
+			a.updateHealth()
+			a.logger.Info("reloaded pricing model", "version", model.Version)
+		},
+		func(err error) {
+			a.logger.Error("pricing model reload failed", "kept_version", valuation.ActiveModel().Version, "error", err)
+		})
+	if err != nil {
+		a.logger.Warn("pricing model hot reload disabled", "error", err)
+	}
+}
+
+// watchTLS reloads the TLS certificate, key and client CAs when their files change,
+// until ctx is done. New connections use the reloaded files; established ones are kept.
+func (a *app) watchTLS(ctx context.Context) {
+	err := a.tls.Watch(ctx,
+		func() {
+			a.logger.Info("reloaded TLS certificate", "not_after", a.tls.Certificate().Leaf.NotAfter)
+		},
+		func(err error) {
+			a.logger.Error("TLS certificate reload failed, keeping the previous certificate", "error", err)
+		})
+	if err != nil {
+		a.logger.Warn("TLS certificate hot reload disabled", "error", err)
+	}
+}
+
+// shutdown reports the service as not serving, then drains the servers and forces
+// them to stop once the drain timeout has passed
+func (a *app) shutdown() {
+	a.health.Shutdown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), a.cfg.DrainTimeout)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	if a.metricsServer != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := a.metricsServer.Shutdown(ctx); err != nil {
+				a.metricsServer.Close()
+			}
+		}()
+	}
+	if a.httpServer != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := a.httpServer.Shutdown(ctx); err != nil {
+				a.httpServer.Close()
+			}
+			a.drain(ctx, a.internalServer)
+		}()
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		a.drain(ctx, a.grpcServer)
+	}()
+	wg.Wait()
+}
+
+// serveHTTP returns the error an HTTP server stopped with, ignoring a regular shutdown
+func serveHTTP(err error) error {
+	if stderrors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
+// drain gracefully stops a gRPC server, forcing it to stop when ctx is done first
+func (a *app) drain(ctx context.Context, s *grpc.Server) {
+	stopped := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		a.logger.Warn("drain timeout reached, cancelling in-flight requests", "timeout", a.cfg.DrainTimeout)
+		s.Stop()
+		<-stopped
+	}
+}
+
+// close releases the listeners, connections and stores of the app
+func (a *app) close() {
+	for i := len(a.closers) - 1; i >= 0; i-- {
+		if err := a.closers[i](); err != nil && !stderrors.Is(err, net.ErrClosed) {
+			a.logger.Error("failed to release resource", "error", err)
+		}
+	}
+	a.closers = nil
+}
@@ -0,0 +1,186 @@
+package main
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jsarcade/property-valuation-service/pkg/logging"
+	"github.com/jsarcade/property-valuation-service/pkg/testutil"
+	"github.com/jsarcade/property-valuation-service/pkg/valuation"
+	pb "github.com/jsarcade/property-valuation-service/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// discardLogger drops the logs of apps started by tests
+var discardLogger = logging.New(io.Discard, slog.LevelError)
+
+func TestAppLifecycle(t *testing.T) {
+	cfg := defaultConfig()
+	cfg.GRPCAddr = "localhost:0"
+	cfg.HTTPAddr = "localhost:0"
+	cfg.MetricsAddr = "localhost:0"
+	cfg.Reflection = true
+	cfg.DrainTimeout = time.Second
+
+	a, err := newApp(cfg, discardLogger)
+	if err != nil {
+		t.Fatalf("newApp failed: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	served := make(chan error, 1)
+	go func() { served <- a.serve(ctx) }()
+
+	conn, err := grpc.NewClient(a.grpcListener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("Failed to connect to server: %v", err)
+	}
+	defer conn.Close()
+
+	t.Run("Health", func(t *testing.T) {
+		health := healthpb.NewHealthClient(conn)
+		for _, service := range []string{"", "valuation.ValuationService"} {
+			resp, err := health.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+			if err != nil {
+				t.Fatalf("Health check of %q failed: %v", service, err)
+			}
+			if resp.Status != healthpb.HealthCheckResponse_SERVING {
+				t.Errorf("Health of %q = %v, want SERVING", service, resp.Status)
+			}
+		}
+	})
+
+	t.Run("Reflection", func(t *testing.T) {
+		if _, ok := a.grpcServer.GetServiceInfo()["grpc.reflection.v1.ServerReflection"]; !ok {
+			t.Errorf("Reflection service is not registered")
+		}
+	})
+
+	t.Run("REST Gateway", func(t *testing.T) {
+		resp, err := http.Get("http://" + a.httpListener.Addr().String() + "/v1/features")
+		if err != nil {
+			t.Fatalf("GET failed: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("Status = %d, want %d", resp.StatusCode, http.StatusOK)
+		}
+	})
+
+	t.Run("Metrics", func(t *testing.T) {
+		client := pb.NewValuationServiceClient(conn)
+		if _, err := client.CalculateValuation(context.Background(), &pb.ValuationRequest{
+			Property: toProto(testutil.CreateTestProperty()),
+		}); err != nil {
+			t.Fatalf("CalculateValuation failed: %v", err)
+		}
+
+		resp, err := http.Get("http://" + a.metricsListener.Addr().String() + "/metrics")
+		if err != nil {
+			t.Fatalf("GET failed: %v", err)
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+
+		for _, want := range []string{
+			`valuation_valuations_total{method="cost",property_type="house"} 1`,
+			`valuation_rpc_duration_seconds_count{code="OK",method="/valuation.ValuationService/CalculateValuation"} 1`,
+			`valuation_rpc_duration_seconds_count{code="OK",method="/valuation.ValuationService/ListFeatures"} 1`,
+		} {
+			if !strings.Contains(string(body), want) {
+				t.Errorf("Metrics do not contain %q", want)
+			}
+		}
+	})
+
+	cancel()
+	select {
+	case err := <-served:
+		if err != nil {
+			t.Errorf("serve returned %v, want nil after a graceful shutdown", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("serve did not return after shutdown")
+	}
+
+	resp, err := a.health.Check(context.Background(), &healthpb.HealthCheckRequest{})
+	if err != nil || resp.Status != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("Health after shutdown = %v (%v), want NOT_SERVING", resp.GetStatus(), err)
+	}
+}
+
+func TestAppReflectionDisabled(t *testing.T) {
+	cfg := defaultConfig()
+	cfg.GRPCAddr = "localhost:0"
+	cfg.HTTPAddr = ""
+	cfg.MetricsAddr = ""
+
+	a, err := newApp(cfg, discardLogger)
+	if err != nil {
+		t.Fatalf("newApp failed: %v", err)
+	}
+	defer a.close()
+
+	if _, ok := a.grpcServer.GetServiceInfo()["grpc.reflection.v1.ServerReflection"]; ok {
+		t.Errorf("Reflection service is registered although disabled")
+	}
+	if a.httpServer != nil {
+		t.Errorf("REST gateway is created although disabled")
+	}
+}
+
+func TestPricingModelReloadChecksLocationZones(t *testing.T) {
+	defer valuation.SetActiveModel(valuation.ActiveModel())
+
+	sample, err := os.ReadFile(filepath.Join("..", "data", "pricing_model.yaml"))
+	if err != nil {
+		t.Fatalf("Failed to read sample pricing model: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "pricing_model.yaml")
+	if err := os.WriteFile(path, sample, 0o644); err != nil {
+		t.Fatalf("Failed to write pricing model: %v", err)
+	}
+
+	cfg := defaultConfig()
+	cfg.GRPCAddr = "localhost:0"
+	cfg.HTTPAddr = ""
+	cfg.MetricsAddr = ""
+	cfg.PricingModel = path
+	cfg.LocationZones = filepath.Join("..", "data", "location_zones.json")
+	a, err := newApp(cfg, discardLogger)
+	if err != nil {
+		t.Fatalf("newApp failed: %v", err)
+	}
+	defer a.close()
+
+	active := valuation.ActiveModel()
+	property := testutil.CreateTestProperty()
+	property.Location = valuation.Location{Latitude: 25.8000, Longitude: -80.1280}
+	if _, _, breakdown := active.CalculateValuationAsOf(property, time.Now()); breakdown.LocationClass != "beach" {
+		t.Errorf("Location class = %q, want beach from the configured zones", breakdown.LocationClass)
+	}
+
+	// A model dropping a class of the zones would leave its zones unclassified
+	withoutBeach := strings.Replace(string(sample), "  beach: 1.25\n", "", 1)
+	if withoutBeach == string(sample) {
+		t.Fatalf("Sample pricing model has no beach multiplier")
+	}
+	if err := os.WriteFile(path, []byte(withoutBeach), 0o644); err != nil {
+		t.Fatalf("Failed to write pricing model: %v", err)
+	}
+	if _, err := a.reloadPricingModel(); err == nil {
+		t.Errorf("Reload of a model without the beach class succeeded")
+	}
+	if valuation.ActiveModel() != active {
+		t.Errorf("Active model = %s after a rejected reload, want the previous model", valuation.ActiveModel().Version)
+	}
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+
+	pb "github.com/jsarcade/property-valuation-service/proto"
+	"github.com/jsarcade/property-valuation-service/pkg/audit"
+	"github.com/jsarcade/property-valuation-service/pkg/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// audit appends the outcome of a valuation request to the audit log, if enabled.
+// Failures to write the log are logged but do not fail the valuation.
+func (s *server) audit(ctx context.Context, req *pb.ValuationRequest, result *pb.ValuationResult, err error) {
+	if s.auditLog == nil {
+		return
+	}
+
+	property := req.GetProperty()
+	rpc, _ := grpc.Method(ctx)
+	entry := audit.Entry{
+		Time:         s.clock.Now(),
+		RequestID:    logging.RequestID(ctx),
+		Principal:    principalName(ctx),
+		Tenant:       tenantID(ctx),
+		RPC:          rpc,
+		Address:      property.GetAddress(),
+		PropertyType: property.GetPropertyType(),
+		Method:       methodName(req.GetMethod()),
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		entry.Caller = p.Addr.String()
+	}
+	if values := metadata.ValueFromIncomingContext(ctx, "user-agent"); len(values) > 0 {
+		entry.UserAgent = values[0]
+	}
+	if location := property.GetLocation(); location != nil {
+		entry.Location = &audit.Location{Latitude: location.GetLatitude(), Longitude: location.GetLongitude()}
+	}
+
+	if err != nil {
+		entry.Error = status.Convert(err).Message()
+	} else {
+		entry.ValuationID = result.ValuationId
+		entry.Method = methodName(result.Method)
+		entry.Value = result.Value
+		entry.Confidence = result.Confidence
+		entry.ModelVersion = result.ModelVersion
+	}
+
+	if err := s.auditLog.Record(entry); err != nil {
+		s.logger.ErrorContext(ctx, "failed to write audit entry", "error", err)
+	}
+}
+
+// auditScenarios audits a scenario simulation as a cost valuation of its base property
+func (s *server) auditScenarios(ctx context.Context, req *pb.ScenarioRequest, resp *pb.ScenarioResponse, err error) {
+	var result *pb.ValuationResult
+	if err == nil {
+		result = &pb.ValuationResult{
+			Method:       pb.ValuationMethod_VALUATION_METHOD_COST,
+			Value:        resp.BaseValue,
+			ModelVersion: resp.ModelVersion,
+		}
+	}
+	s.audit(ctx, &pb.ValuationRequest{Property: req.GetProperty()}, result, err)
+}
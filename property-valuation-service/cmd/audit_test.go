@@ -0,0 +1,183 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jsarcade/property-valuation-service/pkg/audit"
+	"github.com/jsarcade/property-valuation-service/pkg/logging"
+	"github.com/jsarcade/property-valuation-service/pkg/testutil"
+	pb "github.com/jsarcade/property-valuation-service/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// syncBuffer is a buffer that server goroutines can log to while a test reads it
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+// readAuditLog reads every entry of an audit log file
+func readAuditLog(t *testing.T, path string) []audit.Entry {
+	t.Helper()
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("Failed to open audit log: %v", err)
+	}
+	defer file.Close()
+
+	var entries []audit.Entry
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var entry audit.Entry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatalf("Invalid audit line %q: %v", scanner.Text(), err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func TestRequestLoggingAndAudit(t *testing.T) {
+	var logs syncBuffer
+	path := filepath.Join(t.TempDir(), "audit.log")
+	auditLog, err := audit.Open(path, true)
+	if err != nil {
+		t.Fatalf("Failed to open audit log: %v", err)
+	}
+	defer auditLog.Close()
+
+	a := &app{cfg: defaultConfig(), srv: newServer(2, 10), health: health.NewServer(), logger: logging.New(&logs, slog.LevelInfo)}
+	a.srv.logger = a.logger
+	a.srv.auditLog = auditLog
+	grpcServer := a.newGRPCServer()
+	lis, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	go grpcServer.Serve(lis)
+	defer grpcServer.Stop()
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("Failed to connect to server: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewValuationServiceClient(conn)
+
+	property := toProto(testutil.CreateTestProperty())
+	property.Location = &pb.Location{Latitude: 25.76, Longitude: -80.19}
+
+	t.Run("gRPC", func(t *testing.T) {
+		var header metadata.MD
+		ctx := metadata.AppendToOutgoingContext(context.Background(), logging.RequestIDKey, "req-42")
+		resp, err := client.CalculateValuation(ctx, &pb.ValuationRequest{Property: property}, grpc.Header(&header))
+		if err != nil {
+			t.Fatalf("CalculateValuation failed: %v", err)
+		}
+		if got := header.Get(logging.RequestIDKey); len(got) != 1 || got[0] != "req-42" {
+			t.Errorf("Response request ID = %v, want [req-42]", got)
+		}
+
+		// A failed valuation is audited with its error
+		if _, err := client.CalculateValuation(ctx, &pb.ValuationRequest{}); err == nil {
+			t.Fatalf("CalculateValuation without a property succeeded")
+		}
+
+		entries := readAuditLog(t, path)
+		if len(entries) != 2 {
+			t.Fatalf("Audit log has %d entries, want 2", len(entries))
+		}
+		entry := entries[0]
+		if entry.RequestID != "req-42" || entry.RPC != "/valuation.ValuationService/CalculateValuation" || entry.Caller == "" {
+			t.Errorf("Audit entry does not identify the request: %+v", entry)
+		}
+		if entry.Value != resp.Result.Value || entry.PropertyType != property.PropertyType || entry.Method != "cost" || entry.Error != "" {
+			t.Errorf("Audit entry = %+v, want the returned value %.2f", entry, resp.Result.Value)
+		}
+		if entry.Address != audit.Redacted || entry.Location != nil {
+			t.Errorf("Audit entry leaks the property address or location: %+v", entry)
+		}
+		if entries[1].Error == "" {
+			t.Errorf("Failed valuation audited without its error: %+v", entries[1])
+		}
+
+		if !strings.Contains(logs.String(), `"request_id":"req-42"`) || !strings.Contains(logs.String(), `"property_type":"house"`) {
+			t.Errorf("Logs do not describe the request:\n%s", logs.String())
+		}
+	})
+
+	t.Run("Scenarios", func(t *testing.T) {
+		resp, err := client.SimulateScenarios(context.Background(), &pb.ScenarioRequest{
+			Property: property,
+			Scenarios: []*pb.Scenario{{Modifications: []*pb.Modification{
+				{Change: &pb.Modification_AddFeature{AddFeature: "solar_panels"}, Cost: 20000},
+			}}},
+		})
+		if err != nil {
+			t.Fatalf("SimulateScenarios failed: %v", err)
+		}
+
+		entries := readAuditLog(t, path)
+		last := entries[len(entries)-1]
+		if last.RPC != "/valuation.ValuationService/SimulateScenarios" || last.Value != resp.BaseValue || last.Method != "cost" {
+			t.Errorf("Audit entry = %+v, want the base value %.2f of the simulation", last, resp.BaseValue)
+		}
+	})
+
+	t.Run("REST Gateway", func(t *testing.T) {
+		gateway, err := newGateway(context.Background(), conn)
+		if err != nil {
+			t.Fatalf("Failed to create gateway: %v", err)
+		}
+		httpServer := httptest.NewServer(gateway)
+		defer httpServer.Close()
+
+		body, err := protojson.Marshal(&pb.ValuationRequest{Property: property})
+		if err != nil {
+			t.Fatalf("Failed to marshal request: %v", err)
+		}
+		req, _ := http.NewRequest(http.MethodPost, httpServer.URL+"/v1/valuations:calculate", bytes.NewReader(body))
+		req.Header.Set("X-Request-Id", "rest-7")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("POST failed: %v", err)
+		}
+		resp.Body.Close()
+		if got := resp.Header.Get("X-Request-Id"); got != "rest-7" {
+			t.Errorf("X-Request-Id = %q, want rest-7", got)
+		}
+
+		entries := readAuditLog(t, path)
+		if last := entries[len(entries)-1]; last.RequestID != "rest-7" {
+			t.Errorf("Audit request ID = %q, want rest-7", last.RequestID)
+		}
+	})
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/jsarcade/property-valuation-service/proto"
+	"github.com/jsarcade/property-valuation-service/pkg/auth"
+	"github.com/jsarcade/property-valuation-service/pkg/pricing"
+	"github.com/jsarcade/property-valuation-service/pkg/valuation"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errReloadDisabled is returned by pricing model reloads when the model is not loaded from a file
+var errReloadDisabled = status.Error(codes.FailedPrecondition, "the pricing model is not loaded from a file")
+
+// rpcPolicy is the role each RPC requires when authentication is enabled. Health
+// checks stay public so that load balancers can probe the service; RPCs missing
+// from the policy, including ones added later, are restricted to administrators.
+var rpcPolicy = auth.Policy{
+	Public: []string{"/grpc.health.v1.Health/"},
+	Required: map[string]auth.Role{
+		pb.ValuationService_GetValuation_FullMethodName:        auth.RoleViewer,
+		pb.ValuationService_ListValuations_FullMethodName:      auth.RoleViewer,
+		pb.ValuationService_GetValuationAsOf_FullMethodName:    auth.RoleViewer,
+		pb.ValuationService_ListPropertyTypes_FullMethodName:   auth.RoleViewer,
+		pb.ValuationService_ListConditions_FullMethodName:      auth.RoleViewer,
+		pb.ValuationService_ListFeatures_FullMethodName:        auth.RoleViewer,
+		pb.ValuationService_ListLocationClasses_FullMethodName: auth.RoleViewer,
+
+		"/grpc.reflection.v1.ServerReflection/ServerReflectionInfo":      auth.RoleViewer,
+		"/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo": auth.RoleViewer,
+
+		pb.ValuationService_CalculateValuation_FullMethodName:       auth.RoleAppraiser,
+		pb.ValuationService_CalculateSalesComparison_FullMethodName: auth.RoleAppraiser,
+		pb.ValuationService_BatchCalculateValuation_FullMethodName:  auth.RoleAppraiser,
+		pb.ValuationService_StreamValuations_FullMethodName:         auth.RoleAppraiser,
+		pb.ValuationService_SimulateScenarios_FullMethodName:        auth.RoleAppraiser,
+
+		pb.ValuationService_ReloadPricingModel_FullMethodName:  auth.RoleAdmin,
+		pb.ValuationService_ListUsage_FullMethodName:           auth.RoleAdmin,
+		pb.ValuationService_UpsertTenantPricing_FullMethodName: auth.RoleAdmin,
+	},
+	Default: auth.RoleAdmin,
+}
+
+// setupAuth creates the authenticator of the configured API keys and JWKS. Every
+// caller is trusted when neither is configured.
+func (a *app) setupAuth() error {
+	if a.cfg.APIKeys == "" && a.cfg.JWKS == "" {
+		a.logger.Warn("authentication disabled: configure api-keys or jwks to restrict access")
+		return nil
+	}
+
+	authenticator, err := auth.New(auth.Options{
+		APIKeysFile: a.cfg.APIKeys,
+		JWKSFile:    a.cfg.JWKS,
+		Issuer:      a.cfg.JWTIssuer,
+		Audience:    a.cfg.JWTAudience,
+		RoleClaim:   a.cfg.JWTRoleClaim,
+		TenantClaim: a.cfg.JWTTenantClaim,
+		Clock:       a.srv.clock,
+	})
+	if err != nil {
+		return fmt.Errorf("loading credentials: %w", err)
+	}
+	a.authenticator = authenticator
+	a.logger.Info("authentication enabled", "api_keys", a.cfg.APIKeys, "jwks", a.cfg.JWKS)
+	return nil
+}
+
+// authInterceptors returns the interceptors authenticating and authorizing every
+// RPC, or none when authentication is disabled
+func (a *app) authInterceptors() ([]grpc.UnaryServerInterceptor, []grpc.StreamServerInterceptor) {
+	if a.authenticator == nil {
+		return nil, nil
+	}
+	return []grpc.UnaryServerInterceptor{auth.UnaryServerInterceptor(a.authenticator, rpcPolicy)},
+		[]grpc.StreamServerInterceptor{auth.StreamServerInterceptor(a.authenticator, rpcPolicy)}
+}
+
+func (s *server) ReloadPricingModel(ctx context.Context, req *pb.ReloadPricingModelRequest) (*pb.ReloadPricingModelResponse, error) {
+	if s.reloadModel == nil {
+		return nil, errReloadDisabled
+	}
+	model, err := s.reloadModel()
+	if err != nil {
+		// The previous model stays active
+		return nil, status.Errorf(codes.FailedPrecondition, "reloading pricing model: %v", err)
+	}
+	s.logger.InfoContext(ctx, "pricing model reloaded on request", "version", model.Version, "principal", principalName(ctx))
+	return &pb.ReloadPricingModelResponse{ModelVersion: model.Version}, nil
+}
+
+// reloadPricingModel loads the configured pricing model file and activates it
+func (a *app) reloadPricingModel() (*valuation.PricingModel, error) {
+	model, err := pricing.LoadFile(a.cfg.PricingModel)
+	if err != nil {
+		return nil, err
+	}
+	if model, err = a.activateModel(model); err != nil {
+		return nil, err
+	}
+	a.updateHealth()
+	return model, nil
+}
+
+// principalName identifies the authenticated caller of a request, or returns "" when
+// authentication is disabled
+func principalName(ctx context.Context) string {
+	if principal, ok := auth.PrincipalFromContext(ctx); ok {
+		return principal.String()
+	}
+	return ""
+}
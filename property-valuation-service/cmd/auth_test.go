@@ -0,0 +1,167 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jsarcade/property-valuation-service/pkg/auth"
+	"github.com/jsarcade/property-valuation-service/pkg/testutil"
+	"github.com/jsarcade/property-valuation-service/pkg/valuation"
+	pb "github.com/jsarcade/property-valuation-service/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestAuthorization(t *testing.T) {
+	keys := filepath.Join(t.TempDir(), "api_keys.yaml")
+	content := "keys:\n" +
+		"  - {name: dashboard, hash: " + auth.HashAPIKey("viewer-key") + ", role: viewer}\n" +
+		"  - {name: crm, hash: " + auth.HashAPIKey("appraiser-key") + ", role: appraiser}\n" +
+		"  - {name: ops, hash: " + auth.HashAPIKey("admin-key") + ", role: admin}\n"
+	if err := os.WriteFile(keys, []byte(content), 0o600); err != nil {
+		t.Fatalf("Failed to write API keys: %v", err)
+	}
+
+	cfg := defaultConfig()
+	cfg.APIKeys = keys
+	a := &app{cfg: cfg, srv: newServer(2, 10), health: health.NewServer(), logger: discardLogger}
+	a.srv.logger = discardLogger
+	reloads := 0
+	a.srv.reloadModel = func() (*valuation.PricingModel, error) {
+		reloads++
+		return valuation.ActiveModel(), nil
+	}
+	if err := a.setupAuth(); err != nil {
+		t.Fatalf("setupAuth failed: %v", err)
+	}
+
+	grpcServer := a.newGRPCServer()
+	lis, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	go grpcServer.Serve(lis)
+	defer grpcServer.Stop()
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("Failed to connect to server: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewValuationServiceClient(conn)
+	property := toProto(testutil.CreateTestProperty())
+
+	withKey := func(key string) context.Context {
+		if key == "" {
+			return context.Background()
+		}
+		return metadata.AppendToOutgoingContext(context.Background(), auth.APIKeyKey, key)
+	}
+
+	tests := []struct {
+		name     string
+		key      string
+		call     func(ctx context.Context) error
+		wantCode codes.Code
+	}{
+		{"Calculate without credentials", "", func(ctx context.Context) error {
+			_, err := client.CalculateValuation(ctx, &pb.ValuationRequest{Property: property})
+			return err
+		}, codes.Unauthenticated},
+		{"Calculate with an unknown key", "guess", func(ctx context.Context) error {
+			_, err := client.CalculateValuation(ctx, &pb.ValuationRequest{Property: property})
+			return err
+		}, codes.Unauthenticated},
+		{"Calculate as viewer", "viewer-key", func(ctx context.Context) error {
+			_, err := client.CalculateValuation(ctx, &pb.ValuationRequest{Property: property})
+			return err
+		}, codes.PermissionDenied},
+		{"Calculate as appraiser", "appraiser-key", func(ctx context.Context) error {
+			_, err := client.CalculateValuation(ctx, &pb.ValuationRequest{Property: property})
+			return err
+		}, codes.OK},
+		{"Reference data as viewer", "viewer-key", func(ctx context.Context) error {
+			_, err := client.ListPropertyTypes(ctx, &pb.ListPropertyTypesRequest{})
+			return err
+		}, codes.OK},
+		{"Stream as viewer", "viewer-key", func(ctx context.Context) error {
+			stream, err := client.StreamValuations(ctx)
+			if err != nil {
+				return err
+			}
+			stream.CloseSend()
+			_, err = stream.Recv()
+			return err
+		}, codes.PermissionDenied},
+		{"Reload as appraiser", "appraiser-key", func(ctx context.Context) error {
+			_, err := client.ReloadPricingModel(ctx, &pb.ReloadPricingModelRequest{})
+			return err
+		}, codes.PermissionDenied},
+		{"Reload as admin", "admin-key", func(ctx context.Context) error {
+			_, err := client.ReloadPricingModel(ctx, &pb.ReloadPricingModelRequest{})
+			return err
+		}, codes.OK},
+		{"Health check without credentials", "", func(ctx context.Context) error {
+			_, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+			return err
+		}, codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if code := status.Code(tt.call(withKey(tt.key))); code != tt.wantCode {
+				t.Errorf("Code = %v, want %v", code, tt.wantCode)
+			}
+		})
+	}
+	if reloads != 1 {
+		t.Errorf("Pricing model reloaded %d times, want 1", reloads)
+	}
+
+	t.Run("REST Gateway", func(t *testing.T) {
+		gateway, err := newGateway(context.Background(), conn)
+		if err != nil {
+			t.Fatalf("Failed to create gateway: %v", err)
+		}
+		httpServer := httptest.NewServer(gateway)
+		defer httpServer.Close()
+
+		body, err := protojson.Marshal(&pb.ValuationRequest{Property: property})
+		if err != nil {
+			t.Fatalf("Failed to marshal request: %v", err)
+		}
+		for key, want := range map[string]int{"": http.StatusUnauthorized, "viewer-key": http.StatusForbidden, "appraiser-key": http.StatusOK} {
+			req, _ := http.NewRequest(http.MethodPost, httpServer.URL+"/v1/valuations:calculate", bytes.NewReader(body))
+			if key != "" {
+				req.Header.Set("X-Api-Key", key)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("POST failed: %v", err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != want {
+				t.Errorf("Status with key %q = %d, want %d", key, resp.StatusCode, want)
+			}
+		}
+	})
+}
+
+func TestReloadPricingModelDisabled(t *testing.T) {
+	srv := newServer(1, 1)
+	_, err := srv.ReloadPricingModel(context.Background(), &pb.ReloadPricingModelRequest{})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("ReloadPricingModel error = %v, want FailedPrecondition", err)
+	}
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/jsarcade/property-valuation-service/pkg/valuation"
+	pb "github.com/jsarcade/property-valuation-service/proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultMaxBatchSize is the default maximum number of requests in a single batch
+const defaultMaxBatchSize = 1000
+
+// valuationItem values a single request of a batch or stream, reporting a
+// failure as a per-item error instead of failing the whole call
+func (s *server) valuationItem(ctx context.Context, model *valuation.PricingModel, index int, req *pb.ValuationRequest) *pb.ValuationItem {
+	item := &pb.ValuationItem{
+		Index:     int32(index),
+		RequestId: req.GetRequestId(),
+	}
+
+	result, err := s.valuate(ctx, model, req)
+	if err != nil {
+		item.Outcome = &pb.ValuationItem_Error{Error: valuationError(err)}
+		return item
+	}
+	item.Outcome = &pb.ValuationItem_Result{Result: result}
+	return item
+}
+
+// valuationError converts a gRPC status error into a per-item error, flattening
+// any BadRequest details into field violations
+func valuationError(err error) *pb.ValuationError {
+	st := status.Convert(err)
+	valuationErr := &pb.ValuationError{
+		Code:    int32(st.Code()),
+		Message: st.Message(),
+	}
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, v := range badRequest.FieldViolations {
+				valuationErr.FieldViolations = append(valuationErr.FieldViolations, &pb.FieldViolation{
+					Field:       v.Field,
+					Description: v.Description,
+				})
+			}
+		}
+	}
+	return valuationErr
+}
+
+func (s *server) BatchCalculateValuation(ctx context.Context, req *pb.BatchValuationRequest) (*pb.BatchValuationResponse, error) {
+	if len(req.Requests) > s.maxBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch of %d requests exceeds the maximum of %d", len(req.Requests), s.maxBatchSize)
+	}
+
+	// Value the whole batch against a single pricing model snapshot
+	model := s.pricingModel(ctx)
+	items := make([]*pb.ValuationItem, len(req.Requests))
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < min(s.workers, len(req.Requests)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range jobs {
+				items[index] = s.valuationItem(ctx, model, index, req.Requests[index])
+			}
+		}()
+	}
+
+dispatch:
+	for index := range req.Requests {
+		select {
+		case jobs <- index:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+	return &pb.BatchValuationResponse{Items: items}, nil
+}
+
+func (s *server) StreamValuations(stream pb.ValuationService_StreamValuationsServer) error {
+	ctx := stream.Context()
+	items := make(chan *pb.ValuationItem, s.workers)
+	recvErr := make(chan error, 1)
+
+	// Receive requests and value each one in its own goroutine, with at most
+	// s.workers valuations in flight; items are closed once all are done
+	go func() {
+		var wg sync.WaitGroup
+		defer func() {
+			wg.Wait()
+			close(items)
+		}()
+
+		slots := make(chan struct{}, s.workers)
+		for index := 0; ; index++ {
+			req, err := stream.Recv()
+			if err == io.EOF {
+				recvErr <- nil
+				return
+			}
+			if err != nil {
+				recvErr <- err
+				return
+			}
+
+			select {
+			case slots <- struct{}{}:
+			case <-ctx.Done():
+				recvErr <- status.FromContextError(ctx.Err()).Err()
+				return
+			}
+
+			wg.Add(1)
+			go func(index int, req *pb.ValuationRequest) {
+				defer func() {
+					<-slots
+					wg.Done()
+				}()
+				// Each item uses the model active when it is valued so that
+				// long-lived streams pick up pricing model reloads
+				item := s.valuationItem(ctx, s.pricingModel(ctx), index, req)
+				select {
+				case items <- item:
+				case <-ctx.Done():
+				}
+			}(index, req)
+		}
+	}()
+
+	for item := range items {
+		if err := stream.Send(item); err != nil {
+			return err
+		}
+	}
+	return <-recvErr
+}
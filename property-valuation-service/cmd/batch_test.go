@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/jsarcade/property-valuation-service/pkg/testutil"
+	pb "github.com/jsarcade/property-valuation-service/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+// mixedRequests returns valid requests with an invalid one at every third position
+func mixedRequests(n int) []*pb.ValuationRequest {
+	requests := make([]*pb.ValuationRequest, n)
+	for i := range requests {
+		property := testutil.CreateTestProperty()
+		if i%3 == 2 {
+			property.Bedrooms = 0
+		}
+		requests[i] = &pb.ValuationRequest{
+			Property:  toProto(property),
+			RequestId: fmt.Sprintf("req-%d", i),
+		}
+	}
+	return requests
+}
+
+// checkItem verifies an item matches the outcome expected from mixedRequests
+func checkItem(t *testing.T, item *pb.ValuationItem) {
+	t.Helper()
+	if item.RequestId != fmt.Sprintf("req-%d", item.Index) {
+		t.Errorf("Item %d has request ID %q", item.Index, item.RequestId)
+	}
+	if item.Index%3 == 2 {
+		if item.GetError().GetCode() != int32(codes.InvalidArgument) || len(item.GetError().GetFieldViolations()) != 1 ||
+			item.GetError().GetFieldViolations()[0].Field != "bedrooms" {
+			t.Errorf("Item %d: expected bedrooms violation, got %v", item.Index, item.Outcome)
+		}
+		return
+	}
+	if item.GetResult().GetValue() <= 0 {
+		t.Errorf("Item %d: expected positive value, got %v", item.Index, item.Outcome)
+	}
+}
+
+func newTestClient(t *testing.T, srv *server) pb.ValuationServiceClient {
+	server, addr := startTestServer(t, srv)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("Failed to connect to server: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return pb.NewValuationServiceClient(conn)
+}
+
+func TestBatchCalculateValuation(t *testing.T) {
+	client := newTestClient(t, newServer(4, 10))
+	ctx := context.Background()
+
+	t.Run("Mixed Batch", func(t *testing.T) {
+		resp, err := client.BatchCalculateValuation(ctx, &pb.BatchValuationRequest{Requests: mixedRequests(10)})
+		if err != nil {
+			t.Fatalf("BatchCalculateValuation failed: %v", err)
+		}
+		if len(resp.Items) != 10 {
+			t.Fatalf("Expected 10 items, got %d", len(resp.Items))
+		}
+		for i, item := range resp.Items {
+			if int(item.Index) != i {
+				t.Errorf("Item at position %d has index %d", i, item.Index)
+			}
+			checkItem(t, item)
+		}
+	})
+
+	t.Run("Batch Too Large", func(t *testing.T) {
+		_, err := client.BatchCalculateValuation(ctx, &pb.BatchValuationRequest{Requests: mixedRequests(11)})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument code, got %v", status.Code(err))
+		}
+	})
+}
+
+func TestStreamValuations(t *testing.T) {
+	client := newTestClient(t, newServer(4, 10))
+
+	stream, err := client.StreamValuations(context.Background())
+	if err != nil {
+		t.Fatalf("StreamValuations failed: %v", err)
+	}
+
+	requests := mixedRequests(25)
+	go func() {
+		for _, req := range requests {
+			if err := stream.Send(req); err != nil {
+				return
+			}
+		}
+		stream.CloseSend()
+	}()
+
+	seen := make(map[int32]bool)
+	for {
+		item, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Recv failed: %v", err)
+		}
+		if seen[item.Index] {
+			t.Errorf("Item %d received twice", item.Index)
+		}
+		seen[item.Index] = true
+		checkItem(t, item)
+	}
+
+	if len(seen) != len(requests) {
+		t.Errorf("Expected %d items, got %d", len(requests), len(seen))
+	}
+}
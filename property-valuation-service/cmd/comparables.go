@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+
+	pb "github.com/jsarcade/property-valuation-service/proto"
+	"github.com/jsarcade/property-valuation-service/pkg/comparables"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *server) CalculateSalesComparison(ctx context.Context, req *pb.ValuationRequest) (*pb.ValuationResponse, error) {
+	// The dedicated RPC always uses the sales comparison approach, whatever the method
+	req = proto.Clone(req).(*pb.ValuationRequest)
+	req.Method = pb.ValuationMethod_VALUATION_METHOD_SALES_COMPARISON
+
+	result, err := s.valuate(ctx, s.pricingModel(ctx), req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ValuationResponse{Result: result}, nil
+}
+
+// comparablesToProto converts the comparable sales of a result into their gRPC representation
+func comparablesToProto(comps []comparables.Comparable) []*pb.ComparableSale {
+	sales := make([]*pb.ComparableSale, 0, len(comps))
+	for _, comp := range comps {
+		adjustments := make([]*pb.ComparableAdjustment, 0, len(comp.Adjustments))
+		for _, adjustment := range comp.Adjustments {
+			adjustments = append(adjustments, &pb.ComparableAdjustment{
+				Category: adjustment.Category,
+				Amount:   adjustment.Amount,
+			})
+		}
+
+		sales = append(sales, &pb.ComparableSale{
+			Address:         comp.Sale.Address,
+			PropertyType:    comp.Sale.PropertyType,
+			SquareFootage:   int32(comp.Sale.SquareFootage),
+			YearBuilt:       int32(comp.Sale.YearBuilt),
+			Condition:       comp.Sale.Condition,
+			SalePrice:       comp.Sale.Price,
+			SaleDate:        timestamppb.New(comp.Sale.SaleDate),
+			DistanceKm:      comp.DistanceKm,
+			Adjustments:     adjustments,
+			AdjustedPrice:   comp.AdjustedPrice,
+			GrossAdjustment: comp.GrossAdjustment,
+			Weight:          comp.Weight,
+		})
+	}
+	return sales
+}
@@ -0,0 +1,199 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/jsarcade/property-valuation-service/pkg/auth"
+	"gopkg.in/yaml.v3"
+)
+
+// envPrefix prefixes the environment variable of every flag, e.g. VALUATION_GRPC_ADDR for -grpc-addr
+const envPrefix = "VALUATION_"
+
+// config represents the configuration of the valuation server
+type config struct {
+	GRPCAddr       string        `yaml:"grpcAddr"`
+	HTTPAddr       string        `yaml:"httpAddr"`    // The REST gateway is disabled when empty
+	MetricsAddr    string        `yaml:"metricsAddr"` // The metrics endpoint is disabled when empty
+	TLSCert        string        `yaml:"tlsCert"`     // TLS is disabled when no certificate is configured
+	TLSKey         string        `yaml:"tlsKey"`
+	TLSClientCA    string        `yaml:"tlsClientCA"` // Client certificates are not required when empty
+	Reflection     bool          `yaml:"reflection"`
+	APIKeys        string        `yaml:"apiKeys"`        // Authentication is disabled when neither API keys nor a JWKS are configured
+	JWKS           string        `yaml:"jwks"`           // Bearer tokens are rejected when empty
+	JWTIssuer      string        `yaml:"jwtIssuer"`      // Issuer is not checked when empty
+	JWTAudience    string        `yaml:"jwtAudience"`    // Audience is not checked when empty
+	JWTRoleClaim   string        `yaml:"jwtRoleClaim"`   // Claim holding the caller's role
+	JWTTenantClaim string        `yaml:"jwtTenantClaim"` // Claim holding the caller's tenant
+	RateLimits     string        `yaml:"rateLimits"`     // Requests are not rate limited when empty
+	QuotaDB        string        `yaml:"quotaDB"`        // Monthly usage is not counted when empty
+	OTLPEndpoint   string        `yaml:"otlpEndpoint"`   // Spans are not exported over OTLP when empty
+	OTLPInsecure   bool          `yaml:"otlpInsecure"`
+	TraceStdout    bool          `yaml:"traceStdout"`  // Write spans to stdout for local testing
+	LogLevel       string        `yaml:"logLevel"`     // One of debug, info, warn or error
+	AuditLog       string        `yaml:"auditLog"`     // Valuation requests are not audited when empty
+	AuditRedact    bool          `yaml:"auditRedact"`  // Leave addresses and locations out of the audit log
+	DrainTimeout   time.Duration `yaml:"drainTimeout"` // Time in-flight requests get to finish on shutdown
+	Workers        int           `yaml:"workers"`
+	MaxBatchSize   int           `yaml:"maxBatchSize"`
+	PricingModel   string        `yaml:"pricingModel"`
+	LocationZones  string        `yaml:"locationZones"`
+	SalesData      string        `yaml:"salesData"`
+	PriceIndices   string        `yaml:"priceIndices"`
+	HistoryDB      string        `yaml:"historyDB"`
+	TenantPricing  string        `yaml:"tenantPricing"` // Tenants are valued with the base pricing model when empty
+}
+
+// defaultConfig returns the configuration used for settings that are not configured
+func defaultConfig() config {
+	return config{
+		GRPCAddr:       ":50051",
+		HTTPAddr:       ":8080",
+		MetricsAddr:    ":9090",
+		JWTRoleClaim:   auth.DefaultRoleClaim,
+		JWTTenantClaim: auth.DefaultTenantClaim,
+		LogLevel:       "info",
+		DrainTimeout:   20 * time.Second,
+		Workers:        runtime.NumCPU(),
+		MaxBatchSize:   defaultMaxBatchSize,
+	}
+}
+
+// loadConfig builds the server configuration from, in increasing order of precedence,
+// the defaults, the YAML file given by -config or VALUATION_CONFIG, the VALUATION_*
+// environment variables and the command-line flags
+func loadConfig(args []string, getenv func(string) string) (config, error) {
+	cfg := defaultConfig()
+	var path string
+
+	fs := flag.NewFlagSet("server", flag.ContinueOnError)
+	fs.StringVar(&path, "config", "", "path to a YAML configuration file")
+	fs.StringVar(&cfg.GRPCAddr, "grpc-addr", cfg.GRPCAddr, "address the gRPC server listens on")
+	fs.StringVar(&cfg.HTTPAddr, "http-addr", cfg.HTTPAddr, "address of the REST/JSON gateway; the gateway is disabled when empty")
+	fs.StringVar(&cfg.MetricsAddr, "metrics-addr", cfg.MetricsAddr, "address serving Prometheus metrics on /metrics; metrics are not served when empty")
+	fs.StringVar(&cfg.TLSCert, "tls-cert", cfg.TLSCert, "path to the PEM certificate served over TLS; TLS is disabled when empty")
+	fs.StringVar(&cfg.TLSKey, "tls-key", cfg.TLSKey, "path to the PEM private key of the TLS certificate")
+	fs.StringVar(&cfg.TLSClientCA, "tls-client-ca", cfg.TLSClientCA, "path to a PEM bundle of the CAs client certificates must be signed by; enables mutual TLS")
+	fs.BoolVar(&cfg.Reflection, "reflection", cfg.Reflection, "register the gRPC server reflection service")
+	fs.StringVar(&cfg.APIKeys, "api-keys", cfg.APIKeys, "path to a YAML file of hashed API keys and their roles")
+	fs.StringVar(&cfg.JWKS, "jwks", cfg.JWKS, "path to a JWKS file of the public keys bearer tokens are signed with")
+	fs.StringVar(&cfg.JWTIssuer, "jwt-issuer", cfg.JWTIssuer, "issuer bearer tokens must be issued by; not checked when empty")
+	fs.StringVar(&cfg.JWTAudience, "jwt-audience", cfg.JWTAudience, "audience bearer tokens must be issued for; not checked when empty")
+	fs.StringVar(&cfg.JWTRoleClaim, "jwt-role-claim", cfg.JWTRoleClaim, "bearer token claim holding the caller's role, or list of roles")
+	fs.StringVar(&cfg.JWTTenantClaim, "jwt-tenant-claim", cfg.JWTTenantClaim, "bearer token claim holding the tenant the caller acts for")
+	fs.StringVar(&cfg.RateLimits, "rate-limits", cfg.RateLimits, "path to a YAML file of per-client rate limits by RPC and monthly quotas")
+	fs.StringVar(&cfg.QuotaDB, "quota-db", cfg.QuotaDB, "path to the BoltDB file counting the monthly usage of every client; usage is not counted when empty")
+	fs.StringVar(&cfg.OTLPEndpoint, "otlp-endpoint", cfg.OTLPEndpoint, "host:port of an OTLP/gRPC collector receiving traces; traces are not exported over OTLP when empty")
+	fs.BoolVar(&cfg.OTLPInsecure, "otlp-insecure", cfg.OTLPInsecure, "connect to the OTLP collector without TLS")
+	fs.BoolVar(&cfg.TraceStdout, "trace-stdout", cfg.TraceStdout, "write traces to stdout as JSON, for local testing")
+	fs.StringVar(&cfg.LogLevel, "log-level", cfg.LogLevel, "minimum level of the JSON logs written to stderr: debug, info, warn or error")
+	fs.StringVar(&cfg.AuditLog, "audit-log", cfg.AuditLog, "path to the append-only audit log of valuation requests; auditing is disabled when empty")
+	fs.BoolVar(&cfg.AuditRedact, "audit-redact", cfg.AuditRedact, "redact property addresses and locations in the audit log")
+	fs.DurationVar(&cfg.DrainTimeout, "drain-timeout", cfg.DrainTimeout, "time in-flight requests get to finish on shutdown")
+	fs.IntVar(&cfg.Workers, "workers", cfg.Workers, "maximum number of properties valued concurrently per batch or stream")
+	fs.IntVar(&cfg.MaxBatchSize, "max-batch-size", cfg.MaxBatchSize, "maximum number of requests accepted in a single batch")
+	fs.StringVar(&cfg.PricingModel, "pricing-model", cfg.PricingModel, "path to a YAML or JSON pricing model; reloaded when the file changes")
+	fs.StringVar(&cfg.LocationZones, "location-zones", cfg.LocationZones, "path to a JSON file of location zones used to classify property markets")
+	fs.StringVar(&cfg.SalesData, "sales-data", cfg.SalesData, "path to a JSON dataset of recent sales used by the sales comparison approach")
+	fs.StringVar(&cfg.PriceIndices, "price-indices", cfg.PriceIndices, "path to a CSV file, or a directory of CSV files, of regional house-price indices")
+	fs.StringVar(&cfg.HistoryDB, "history-db", cfg.HistoryDB, "path to the BoltDB file recording every valuation; history is disabled when empty")
+	fs.StringVar(&cfg.TenantPricing, "tenant-pricing", cfg.TenantPricing, "path to the BoltDB file storing the pricing overrides of every tenant; tenants use the base pricing model when empty")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage of server:\n")
+		fs.PrintDefaults()
+		fmt.Fprintf(fs.Output(), "\nEvery flag can also be set with a %s environment variable, e.g. %sGRPC_ADDR.\n", envPrefix, envPrefix)
+	}
+	if err := fs.Parse(args); err != nil {
+		return config{}, err
+	}
+
+	// Remember the flags given on the command line, then rebuild the configuration
+	// from the lower precedence sources before applying them again
+	explicit := make(map[string]string)
+	fs.Visit(func(f *flag.Flag) { explicit[f.Name] = f.Value.String() })
+	if path == "" {
+		path = getenv(envPrefix + "CONFIG")
+	}
+	cfg = defaultConfig()
+
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return config{}, fmt.Errorf("reading config: %w", err)
+		}
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(&cfg); err != nil {
+			return config{}, fmt.Errorf("parsing config %s: %w", path, err)
+		}
+	}
+
+	var err error
+	fs.VisitAll(func(f *flag.Flag) {
+		name := envPrefix + strings.ToUpper(strings.ReplaceAll(f.Name, "-", "_"))
+		if value := getenv(name); value != "" && f.Name != "config" && err == nil {
+			if setErr := fs.Set(f.Name, value); setErr != nil {
+				err = fmt.Errorf("invalid %s: %w", name, setErr)
+			}
+		}
+	})
+	if err != nil {
+		return config{}, err
+	}
+
+	for name, value := range explicit {
+		if name != "config" {
+			fs.Set(name, value) // Already parsed successfully
+		}
+	}
+
+	if err := cfg.validate(); err != nil {
+		return config{}, err
+	}
+	return cfg, nil
+}
+
+// validate checks that the configuration is consistent
+func (c config) validate() error {
+	if c.GRPCAddr == "" {
+		return fmt.Errorf("grpc-addr is required")
+	}
+	if (c.TLSCert == "") != (c.TLSKey == "") {
+		return fmt.Errorf("tls-cert and tls-key must be configured together")
+	}
+	if c.TLSClientCA != "" && c.TLSCert == "" {
+		return fmt.Errorf("tls-client-ca requires tls-cert and tls-key")
+	}
+	if c.JWKS == "" && (c.JWTIssuer != "" || c.JWTAudience != "") {
+		return fmt.Errorf("jwt-issuer and jwt-audience require jwks")
+	}
+	if c.JWKS != "" && c.JWTRoleClaim == "" {
+		return fmt.Errorf("jwt-role-claim must not be empty")
+	}
+	if c.JWKS != "" && c.JWTTenantClaim == "" {
+		return fmt.Errorf("jwt-tenant-claim must not be empty")
+	}
+	if _, err := c.logLevel(); err != nil {
+		return err
+	}
+	if c.DrainTimeout < 0 {
+		return fmt.Errorf("drain-timeout must not be negative, got %s", c.DrainTimeout)
+	}
+	return nil
+}
+
+// logLevel returns the minimum level of the logs
+func (c config) logLevel() (slog.Level, error) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(c.LogLevel)); err != nil {
+		return 0, fmt.Errorf("invalid log-level %q: want debug, info, warn or error", c.LogLevel)
+	}
+	return level, nil
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// fakeEnv returns a getenv function backed by a map
+func fakeEnv(vars map[string]string) func(string) string {
+	return func(name string) string { return vars[name] }
+}
+
+func TestLoadConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	err := os.WriteFile(path, []byte("grpcAddr: :6000\nhttpAddr: \"\"\ndrainTimeout: 5s\nworkers: 3\nreflection: true\n"), 0o644)
+	if err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	t.Run("Defaults", func(t *testing.T) {
+		cfg, err := loadConfig(nil, fakeEnv(nil))
+		if err != nil {
+			t.Fatalf("loadConfig failed: %v", err)
+		}
+		if cfg != defaultConfig() {
+			t.Errorf("Config = %+v, want defaults %+v", cfg, defaultConfig())
+		}
+	})
+
+	t.Run("Precedence", func(t *testing.T) {
+		env := fakeEnv(map[string]string{
+			"VALUATION_CONFIG":       path,
+			"VALUATION_GRPC_ADDR":    ":7000",
+			"VALUATION_WORKERS":      "5",
+			"VALUATION_TRACE_STDOUT": "true",
+		})
+		cfg, err := loadConfig([]string{"-workers", "9"}, env)
+		if err != nil {
+			t.Fatalf("loadConfig failed: %v", err)
+		}
+		if cfg.GRPCAddr != ":7000" {
+			t.Errorf("GRPCAddr = %q, want the environment's :7000", cfg.GRPCAddr)
+		}
+		if cfg.Workers != 9 {
+			t.Errorf("Workers = %d, want the flag's 9", cfg.Workers)
+		}
+		if cfg.HTTPAddr != "" || cfg.DrainTimeout != 5*time.Second || !cfg.Reflection {
+			t.Errorf("Config = %+v, want the file's http address, drain timeout and reflection", cfg)
+		}
+		if !cfg.TraceStdout {
+			t.Errorf("TraceStdout = false, want the environment's true")
+		}
+		if cfg.MaxBatchSize != defaultMaxBatchSize {
+			t.Errorf("MaxBatchSize = %d, want the default %d", cfg.MaxBatchSize, defaultMaxBatchSize)
+		}
+	})
+
+	t.Run("Config Flag", func(t *testing.T) {
+		cfg, err := loadConfig([]string{"-config", path}, fakeEnv(nil))
+		if err != nil {
+			t.Fatalf("loadConfig failed: %v", err)
+		}
+		if cfg.GRPCAddr != ":6000" {
+			t.Errorf("GRPCAddr = %q, want the file's :6000", cfg.GRPCAddr)
+		}
+	})
+
+	unknown := filepath.Join(t.TempDir(), "unknown.yaml")
+	if err := os.WriteFile(unknown, []byte("grpcAdr: :6000\n"), 0o644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	invalid := []struct {
+		name string
+		args []string
+		env  map[string]string
+	}{
+		{"Unknown file key", []string{"-config", unknown}, nil},
+		{"Missing file", []string{"-config", filepath.Join(t.TempDir(), "missing.yaml")}, nil},
+		{"Invalid environment value", nil, map[string]string{"VALUATION_DRAIN_TIMEOUT": "soon"}},
+		{"Unknown flag", []string{"-port", "80"}, nil},
+		{"Certificate without key", []string{"-tls-cert", "server.pem"}, nil},
+		{"Client CA without certificate", []string{"-tls-client-ca", "ca.pem"}, nil},
+		{"Invalid log level", []string{"-log-level", "verbose"}, nil},
+		{"Issuer without JWKS", []string{"-jwt-issuer", "https://login.example.com"}, nil},
+		{"Empty tenant claim", []string{"-jwks", "jwks.json", "-jwt-tenant-claim", ""}, nil},
+		{"Empty gRPC address", []string{"-grpc-addr", ""}, nil},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := loadConfig(tt.args, fakeEnv(tt.env)); err == nil {
+				t.Errorf("loadConfig succeeded, want an error")
+			}
+		})
+	}
+}
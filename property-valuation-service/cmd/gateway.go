@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+
+	pb "github.com/jsarcade/property-valuation-service/proto"
+	"github.com/jsarcade/property-valuation-service/pkg/auth"
+	"github.com/jsarcade/property-valuation-service/pkg/logging"
+	"github.com/jsarcade/property-valuation-service/pkg/ratelimit"
+	"github.com/jsarcade/property-valuation-service/pkg/tracing"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// newGateway returns an HTTP handler serving the valuation API as JSON together with
+// its OpenAPI specification. Requests are forwarded over conn so that they go through
+// the same gRPC server as gRPC clients.
+func newGateway(ctx context.Context, conn *grpc.ClientConn) (http.Handler, error) {
+	// Zero values are part of the response, and unknown request fields are rejected
+	// rather than silently ignored
+	gateway := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{EmitUnpopulated: true},
+		}),
+		runtime.WithIncomingHeaderMatcher(matchHeader),
+		runtime.WithOutgoingHeaderMatcher(matchOutgoingHeader),
+	)
+	if err := pb.RegisterValuationServiceHandlerClient(ctx, gateway, pb.NewValuationServiceClient(conn)); err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/v1/", gateway)
+	mux.HandleFunc("GET /openapi.json", serveOpenAPISpec)
+	return mux, nil
+}
+
+// matchHeader forwards the request ID, API key and trace context headers of HTTP
+// callers as gRPC metadata under their own names, so that REST requests keep the
+// caller's request ID, authenticate like gRPC requests and continue the caller's
+// trace. The gateway forwards the Authorization header itself.
+func matchHeader(key string) (string, bool) {
+	key = strings.ToLower(key)
+	if key == logging.RequestIDKey || key == auth.APIKeyKey || slices.Contains(tracing.PropagationHeaders, key) {
+		return key, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// matchOutgoingHeader returns the request ID and retry delay to HTTP callers as
+// X-Request-Id and Retry-After, and other response metadata with the gateway's
+// Grpc-Metadata- prefix
+func matchOutgoingHeader(key string) (string, bool) {
+	if key == logging.RequestIDKey || key == ratelimit.RetryAfterKey {
+		return http.CanonicalHeaderKey(key), true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
+// serveOpenAPISpec serves the OpenAPI specification of the gateway
+func serveOpenAPISpec(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(pb.OpenAPISpec)
+}
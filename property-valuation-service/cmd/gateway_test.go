@@ -0,0 +1,141 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jsarcade/property-valuation-service/pkg/testutil"
+	pb "github.com/jsarcade/property-valuation-service/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// startTestGateway starts a REST gateway in front of a test gRPC server
+func startTestGateway(t *testing.T, srv *server) *httptest.Server {
+	t.Helper()
+	grpcServer, addr := startTestServer(t, srv)
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("Failed to connect to server: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	gateway, err := newGateway(context.Background(), conn)
+	if err != nil {
+		t.Fatalf("Failed to create gateway: %v", err)
+	}
+
+	httpServer := httptest.NewServer(gateway)
+	t.Cleanup(httpServer.Close)
+	return httpServer
+}
+
+func TestGateway(t *testing.T) {
+	httpServer := startTestGateway(t, newServer(4, 10))
+
+	post := func(t *testing.T, path, body string) *http.Response {
+		t.Helper()
+		resp, err := http.Post(httpServer.URL+path, "application/json", strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("POST %s failed: %v", path, err)
+		}
+		t.Cleanup(func() { resp.Body.Close() })
+		return resp
+	}
+
+	t.Run("Calculate Valuation", func(t *testing.T) {
+		body, err := protojson.Marshal(&pb.ValuationRequest{Property: toProto(testutil.CreateTestProperty())})
+		if err != nil {
+			t.Fatalf("Failed to marshal request: %v", err)
+		}
+
+		resp := post(t, "/v1/valuations:calculate", string(body))
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("Status = %d, want %d", resp.StatusCode, http.StatusOK)
+		}
+		var result struct {
+			Result struct {
+				Value        float64 `json:"value"`
+				ModelVersion string  `json:"modelVersion"`
+			} `json:"result"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			t.Fatalf("Failed to decode response: %v", err)
+		}
+		if result.Result.Value <= 0 || result.Result.ModelVersion == "" {
+			t.Errorf("Result = %+v, want a positive value and a model version", result.Result)
+		}
+	})
+
+	t.Run("Invalid Property", func(t *testing.T) {
+		resp := post(t, "/v1/valuations:calculate", `{"property": {"propertyType": "castle", "location": {"latitude": "NaN", "longitude": 0}}}`)
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("Status = %d, want %d", resp.StatusCode, http.StatusBadRequest)
+		}
+		var st struct {
+			Details []struct {
+				FieldViolations []struct {
+					Field string `json:"field"`
+				} `json:"fieldViolations"`
+			} `json:"details"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&st); err != nil {
+			t.Fatalf("Failed to decode error: %v", err)
+		}
+		fields := make(map[string]bool)
+		for _, detail := range st.Details {
+			for _, v := range detail.FieldViolations {
+				fields[v.Field] = true
+			}
+		}
+		if !fields["property_type"] || !fields["square_footage"] || !fields["location"] {
+			t.Errorf("Field violations = %v, want property_type, square_footage and location", fields)
+		}
+	})
+
+	t.Run("Unknown Field", func(t *testing.T) {
+		resp := post(t, "/v1/valuations:calculate", `{"propery": {}}`)
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("Status = %d, want %d", resp.StatusCode, http.StatusBadRequest)
+		}
+	})
+
+	t.Run("History Disabled", func(t *testing.T) {
+		resp, err := http.Get(httpServer.URL + "/v1/valuations/some-id")
+		if err != nil {
+			t.Fatalf("GET failed: %v", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusBadRequest {
+			t.Errorf("Status = %d, want %d for FailedPrecondition", resp.StatusCode, http.StatusBadRequest)
+		}
+	})
+
+	t.Run("OpenAPI Spec", func(t *testing.T) {
+		resp, err := http.Get(httpServer.URL + "/openapi.json")
+		if err != nil {
+			t.Fatalf("GET failed: %v", err)
+		}
+		defer resp.Body.Close()
+
+		var spec struct {
+			Swagger string                     `json:"swagger"`
+			Paths   map[string]json.RawMessage `json:"paths"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&spec); err != nil {
+			t.Fatalf("Failed to decode spec: %v", err)
+		}
+		for _, path := range []string{"/v1/valuations:calculate", "/v1/valuations/{id}", "/v1/scenarios:simulate"} {
+			if _, ok := spec.Paths[path]; !ok {
+				t.Errorf("Spec has no %s path", path)
+			}
+		}
+	})
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"context"
+	stderrors "errors"
+	"time"
+
+	pb "github.com/jsarcade/property-valuation-service/proto"
+	"github.com/jsarcade/property-valuation-service/pkg/auth"
+	"github.com/jsarcade/property-valuation-service/pkg/errors"
+	"github.com/jsarcade/property-valuation-service/pkg/history"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultHistoryPageSize = 100
+	maxHistoryPageSize     = 1000
+)
+
+// errHistoryDisabled is returned by the history RPCs when no history store is configured
+var errHistoryDisabled = status.Error(codes.FailedPrecondition, "valuation history is not enabled")
+
+// record stores a successful valuation made at the given time in the history and sets
+// its ID on the result. Valuations are still returned when they cannot be stored.
+func (s *server) record(ctx context.Context, req *pb.ValuationRequest, result *pb.ValuationResult, createdAt time.Time) {
+	if s.history == nil {
+		return
+	}
+
+	record := &pb.ValuationRecord{
+		Address:      req.GetProperty().GetAddress(),
+		CreatedAt:    timestamppb.New(createdAt),
+		ModelVersion: result.ModelVersion,
+		Request:      req,
+		Result:       result,
+		TenantId:     result.TenantId,
+	}
+	if err := s.history.Save(record); err != nil {
+		s.logger.ErrorContext(ctx, "failed to record valuation", "error", err)
+		return
+	}
+	result.ValuationId = record.Id
+}
+
+func (s *server) GetValuation(ctx context.Context, req *pb.GetValuationRequest) (*pb.ValuationRecord, error) {
+	if s.history == nil {
+		return nil, errHistoryDisabled
+	}
+	if req.GetId() == "" {
+		return nil, errors.ConvertToGRPCError(&errors.ValidationError{Field: "id", Message: errors.ErrValuationIDRequired})
+	}
+
+	record, err := s.history.Get(historyScope(ctx), req.GetId())
+	if err != nil {
+		return nil, historyError(err)
+	}
+	return record, nil
+}
+
+func (s *server) ListValuations(ctx context.Context, req *pb.ListValuationsRequest) (*pb.ListValuationsResponse, error) {
+	if s.history == nil {
+		return nil, errHistoryDisabled
+	}
+
+	var violations errors.ValidationErrors
+	if history.NormalizeAddress(req.GetAddress()) == "" {
+		violations = append(violations, &errors.ValidationError{Field: "address", Message: errors.ErrAddressRequired})
+	}
+	var from, to time.Time
+	if req.GetStartTime() != nil {
+		from = req.GetStartTime().AsTime()
+		if beforeEpoch(from) {
+			violations = append(violations, &errors.ValidationError{Field: "start_time", Message: errors.ErrTimeBeforeEpoch})
+		}
+	}
+	if req.GetEndTime() != nil {
+		to = req.GetEndTime().AsTime()
+		if beforeEpoch(to) {
+			violations = append(violations, &errors.ValidationError{Field: "end_time", Message: errors.ErrTimeBeforeEpoch})
+		}
+	}
+	if !from.IsZero() && !to.IsZero() && !to.After(from) {
+		violations = append(violations, &errors.ValidationError{Field: "end_time", Message: errors.ErrInvalidTimeRange})
+	}
+	pageSize := int(req.GetPageSize())
+	if pageSize < 0 || pageSize > maxHistoryPageSize {
+		violations = append(violations, &errors.ValidationError{Field: "page_size", Message: errors.ErrInvalidPageSize})
+	}
+	if len(violations) > 0 {
+		return nil, errors.ConvertToGRPCError(violations)
+	}
+	if pageSize == 0 {
+		pageSize = defaultHistoryPageSize
+	}
+
+	records, nextPageToken, err := s.history.List(historyScope(ctx), req.GetAddress(), from, to, pageSize, req.GetPageToken())
+	if err != nil {
+		return nil, historyError(err)
+	}
+	return &pb.ListValuationsResponse{Valuations: records, NextPageToken: nextPageToken}, nil
+}
+
+func (s *server) GetValuationAsOf(ctx context.Context, req *pb.GetValuationAsOfRequest) (*pb.ValuationRecord, error) {
+	if s.history == nil {
+		return nil, errHistoryDisabled
+	}
+	if history.NormalizeAddress(req.GetAddress()) == "" {
+		return nil, errors.ConvertToGRPCError(&errors.ValidationError{Field: "address", Message: errors.ErrAddressRequired})
+	}
+
+	asOf := s.clock.Now()
+	if req.GetAsOf() != nil {
+		asOf = req.GetAsOf().AsTime()
+		if beforeEpoch(asOf) {
+			return nil, errors.ConvertToGRPCError(&errors.ValidationError{Field: "as_of", Message: errors.ErrTimeBeforeEpoch})
+		}
+	}
+
+	record, err := s.history.AsOf(historyScope(ctx), req.GetAddress(), asOf)
+	if err != nil {
+		return nil, historyError(err)
+	}
+	return record, nil
+}
+
+// historyScope returns the valuations the caller may read: those of its tenant,
+// or those of every tenant for administrators
+func historyScope(ctx context.Context) history.Scope {
+	if principal, ok := auth.PrincipalFromContext(ctx); ok && principal.Role.Allows(auth.RoleAdmin) {
+		return history.Scope{AllTenants: true}
+	}
+	return history.Scope{Tenant: tenantID(ctx)}
+}
+
+// beforeEpoch reports whether t is before 1970, which the history cannot index
+func beforeEpoch(t time.Time) bool {
+	return t.Before(time.Unix(0, 0))
+}
+
+// historyError converts an error returned by the history store into a gRPC status
+func historyError(err error) error {
+	switch {
+	case stderrors.Is(err, history.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case stderrors.Is(err, history.ErrInvalidPageToken):
+		return errors.ConvertToGRPCError(&errors.ValidationError{Field: "page_token", Message: errors.ErrInvalidPageToken})
+	default:
+		return errors.ConvertToGRPCError(err)
+	}
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jsarcade/property-valuation-service/pkg/history"
+	"github.com/jsarcade/property-valuation-service/pkg/testutil"
+	pb "github.com/jsarcade/property-valuation-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestValuationHistory(t *testing.T) {
+	store, err := history.Open(filepath.Join(t.TempDir(), "history.db"))
+	if err != nil {
+		t.Fatalf("Failed to open history: %v", err)
+	}
+	defer store.Close()
+
+	srv := newServer(4, 10)
+	srv.history = store
+	client := newTestClient(t, srv)
+	ctx := context.Background()
+
+	property := testutil.CreateTestProperty()
+	start := time.Now()
+	var ids []string
+	for _, bedrooms := range []int{3, 4} {
+		property.Bedrooms = bedrooms
+		resp, err := client.CalculateValuation(ctx, &pb.ValuationRequest{Property: toProto(property)})
+		if err != nil {
+			t.Fatalf("CalculateValuation failed: %v", err)
+		}
+		if resp.Result.ValuationId == "" {
+			t.Fatalf("Result has no valuation ID")
+		}
+		ids = append(ids, resp.Result.ValuationId)
+	}
+
+	t.Run("Get Valuation", func(t *testing.T) {
+		record, err := client.GetValuation(ctx, &pb.GetValuationRequest{Id: ids[0]})
+		if err != nil {
+			t.Fatalf("GetValuation failed: %v", err)
+		}
+		if record.Address != property.Address || record.Request.GetProperty().GetBedrooms() != 3 ||
+			record.Result.GetBreakdown() == nil || record.ModelVersion == "" {
+			t.Errorf("Unexpected record: %v", record)
+		}
+
+		_, err = client.GetValuation(ctx, &pb.GetValuationRequest{Id: "missing"})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("Expected NotFound, got %v", err)
+		}
+	})
+
+	t.Run("List Valuations", func(t *testing.T) {
+		resp, err := client.ListValuations(ctx, &pb.ListValuationsRequest{
+			Address:   property.Address,
+			StartTime: timestamppb.New(start),
+			PageSize:  1,
+		})
+		if err != nil {
+			t.Fatalf("ListValuations failed: %v", err)
+		}
+		if len(resp.Valuations) != 1 || resp.Valuations[0].Id != ids[0] || resp.NextPageToken == "" {
+			t.Fatalf("Unexpected first page: %v", resp)
+		}
+
+		resp, err = client.ListValuations(ctx, &pb.ListValuationsRequest{
+			Address:   property.Address,
+			StartTime: timestamppb.New(start),
+			PageSize:  1,
+			PageToken: resp.NextPageToken,
+		})
+		if err != nil {
+			t.Fatalf("ListValuations failed: %v", err)
+		}
+		if len(resp.Valuations) != 1 || resp.Valuations[0].Id != ids[1] {
+			t.Errorf("Unexpected second page: %v", resp)
+		}
+
+		_, err = client.ListValuations(ctx, &pb.ListValuationsRequest{PageSize: -1})
+		st, _ := status.FromError(err)
+		violations := fieldViolations(st)
+		if _, ok := violations["page_size"]; st.Code() != codes.InvalidArgument || !ok || violations["address"] == "" {
+			t.Errorf("Expected address and page size violations, got %v", err)
+		}
+
+		// Times before 1970 cannot be indexed and must not wrap around
+		_, err = client.ListValuations(ctx, &pb.ListValuationsRequest{
+			Address:   property.Address,
+			StartTime: timestamppb.New(time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC)),
+			EndTime:   timestamppb.New(time.Date(1950, 1, 1, 0, 0, 0, 0, time.UTC)),
+		})
+		st, _ = status.FromError(err)
+		violations = fieldViolations(st)
+		if st.Code() != codes.InvalidArgument || violations["start_time"] == "" || violations["end_time"] == "" {
+			t.Errorf("Expected start and end time violations, got %v", err)
+		}
+	})
+
+	t.Run("Get Valuation As Of", func(t *testing.T) {
+		record, err := client.GetValuationAsOf(ctx, &pb.GetValuationAsOfRequest{Address: property.Address})
+		if err != nil {
+			t.Fatalf("GetValuationAsOf failed: %v", err)
+		}
+		if record.Id != ids[1] {
+			t.Errorf("GetValuationAsOf returned %s, want latest valuation %s", record.Id, ids[1])
+		}
+
+		_, err = client.GetValuationAsOf(ctx, &pb.GetValuationAsOfRequest{
+			Address: property.Address,
+			AsOf:    timestamppb.New(start.Add(-time.Hour)),
+		})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("Expected NotFound before the first valuation, got %v", err)
+		}
+
+		_, err = client.GetValuationAsOf(ctx, &pb.GetValuationAsOfRequest{
+			Address: property.Address,
+			AsOf:    timestamppb.New(time.Date(1969, 7, 20, 0, 0, 0, 0, time.UTC)),
+		})
+		st, _ := status.FromError(err)
+		if st.Code() != codes.InvalidArgument || fieldViolations(st)["as_of"] == "" {
+			t.Errorf("Expected an as_of violation, got %v", err)
+		}
+	})
+}
+
+func TestValuationHistoryDisabled(t *testing.T) {
+	client := newTestClient(t, newServer(4, 10))
+
+	_, err := client.GetValuation(context.Background(), &pb.GetValuationRequest{Id: "any"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Expected FailedPrecondition, got %v", err)
+	}
+}
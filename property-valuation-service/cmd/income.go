@@ -0,0 +1,60 @@
+package main
+
+import (
+	pb "github.com/jsarcade/property-valuation-service/proto"
+	"github.com/jsarcade/property-valuation-service/pkg/income"
+)
+
+// incomeDataFromProto converts income data received over gRPC
+func incomeDataFromProto(data *pb.IncomeData) income.Data {
+	incomeData := income.Data{
+		OtherIncome:       data.OtherIncome,
+		VacancyRate:       data.VacancyRate,
+		OperatingExpenses: data.OperatingExpenses,
+		CapRate:           data.CapRate,
+	}
+	for _, lease := range data.RentRoll {
+		incomeData.RentRoll = append(incomeData.RentRoll, income.Lease{
+			Tenant:        lease.Tenant,
+			SquareFootage: int(lease.SquareFootage),
+			AnnualRent:    lease.AnnualRent,
+		})
+	}
+	if dcf := data.Dcf; dcf != nil {
+		incomeData.DCF = &income.DCFOptions{
+			HoldingPeriodYears: int(dcf.HoldingPeriodYears),
+			DiscountRate:       dcf.DiscountRate,
+			RentGrowthRate:     dcf.RentGrowthRate,
+			ExpenseGrowthRate:  dcf.ExpenseGrowthRate,
+			TerminalCapRate:    dcf.TerminalCapRate,
+			SellingCostRate:    dcf.SellingCostRate,
+		}
+	}
+	return incomeData
+}
+
+// incomeAnalysisToProto converts an income approach result into its gRPC representation
+func incomeAnalysisToProto(result income.Result) *pb.IncomeAnalysis {
+	analysis := &pb.IncomeAnalysis{
+		PotentialGrossIncome:      result.PotentialGrossIncome,
+		VacancyLoss:               result.VacancyLoss,
+		EffectiveGrossIncome:      result.EffectiveGrossIncome,
+		OperatingExpenses:         result.OperatingExpenses,
+		NetOperatingIncome:        result.NetOperatingIncome,
+		CapRate:                   result.CapRate,
+		DirectCapitalizationValue: result.DirectCapitalizationValue,
+	}
+	if result.DCF != nil {
+		for _, cashFlow := range result.DCF.CashFlows {
+			analysis.CashFlows = append(analysis.CashFlows, &pb.CashFlow{
+				Year:               int32(cashFlow.Year),
+				NetOperatingIncome: cashFlow.NetOperatingIncome,
+				PresentValue:       cashFlow.PresentValue,
+			})
+		}
+		analysis.ReversionValue = result.DCF.ReversionValue
+		analysis.PresentReversionValue = result.DCF.PresentReversionValue
+		analysis.DcfValue = result.DCF.Value
+	}
+	return analysis
+}
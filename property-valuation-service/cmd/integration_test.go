@@ -0,0 +1,460 @@
+package main
+
+import (
+	"context"
+	"math"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/jsarcade/property-valuation-service/pkg/clock"
+	"github.com/jsarcade/property-valuation-service/pkg/testutil"
+	"github.com/jsarcade/property-valuation-service/pkg/valuation"
+	pb "github.com/jsarcade/property-valuation-service/proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// toProto converts a test property into its gRPC representation
+func toProto(property valuation.Property) *pb.Property {
+	return &pb.Property{
+		Address:          property.Address,
+		PropertyType:     property.PropertyType,
+		Bedrooms:         int32(property.Bedrooms),
+		Bathrooms:        int32(property.Bathrooms),
+		SquareFootage:    int32(property.SquareFootage),
+		YearBuilt:        int32(property.YearBuilt),
+		Condition:        property.Condition,
+		MaintenanceLevel: property.MaintenanceLevel,
+		RenovationStatus: property.RenovationStatus,
+		Features:         property.Features,
+	}
+}
+
+// fieldViolations extracts the BadRequest field violations from a gRPC status
+func fieldViolations(st *status.Status) map[string]string {
+	violations := make(map[string]string)
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, v := range badRequest.FieldViolations {
+				violations[v.Field] = v.Description
+			}
+		}
+	}
+	return violations
+}
+
+func TestValuationIntegration(t *testing.T) {
+	// Start the server
+	server, addr := startTestServer(t, newServer(4, 10))
+	defer server.Stop()
+
+	// Create a client
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("Failed to connect to server: %v", err)
+	}
+	defer conn.Close()
+
+	client := pb.NewValuationServiceClient(conn)
+	ctx := context.Background()
+
+	t.Run("Valid Property", func(t *testing.T) {
+		property := testutil.CreateTestProperty()
+		resp, err := client.CalculateValuation(ctx, &pb.ValuationRequest{
+			Property: toProto(property),
+		})
+
+		if err != nil {
+			t.Errorf("CalculateValuation failed: %v", err)
+			return
+		}
+
+		if resp.Result.Value <= 0 {
+			t.Errorf("Expected positive value, got %v", resp.Result.Value)
+		}
+
+		if resp.Result.Confidence <= 0 || resp.Result.Confidence > 1 {
+			t.Errorf("Expected confidence between 0 and 1, got %v", resp.Result.Confidence)
+		}
+
+		if resp.Result.Breakdown.GetFinalValue() != resp.Result.Value {
+			t.Errorf("Expected breakdown final value %v, got %v", resp.Result.Value, resp.Result.Breakdown.GetFinalValue())
+		}
+	})
+
+	t.Run("Condition Issues", func(t *testing.T) {
+		property := testutil.CreateTestProperty()
+		property.Condition = "excellent"
+		property.MaintenanceLevel = "excellent"
+		property.RenovationStatus = "recent"
+		property.Features = []string{"energy_efficient", "modern_appliances"}
+
+		resp, err := client.CalculateValuation(ctx, &pb.ValuationRequest{
+			Property: toProto(property),
+		})
+		if err != nil {
+			t.Fatalf("CalculateValuation failed: %v", err)
+		}
+
+		var found *pb.Issue
+		for _, issue := range resp.Result.ValidationIssues {
+			if issue.Description == "Missing required feature: smart_home" {
+				found = issue
+			}
+		}
+		if found == nil {
+			t.Fatalf("Expected missing smart_home issue, got %v", resp.Result.ValidationIssues)
+		}
+		if found.Category != "feature" || found.Field != "features" || found.Severity != 0.7 {
+			t.Errorf("Unexpected issue details: %v", found)
+		}
+		if len(resp.Result.Issues) != len(resp.Result.ValidationIssues) {
+			t.Errorf("Expected %d plain-text issues, got %d", len(resp.Result.ValidationIssues), len(resp.Result.Issues))
+		}
+	})
+
+	t.Run("Income Approach", func(t *testing.T) {
+		property := testutil.CreateTestProperty()
+		property.PropertyType = "office_class_a"
+		income := &pb.IncomeData{
+			RentRoll:          []*pb.Lease{{Tenant: "Acme Corp", AnnualRent: 300000}},
+			VacancyRate:       0.1,
+			OperatingExpenses: 70000,
+			CapRate:           0.08,
+		}
+
+		resp, err := client.CalculateValuation(ctx, &pb.ValuationRequest{
+			Property: toProto(property),
+			Method:   pb.ValuationMethod_VALUATION_METHOD_INCOME,
+			Income:   income,
+		})
+		if err != nil {
+			t.Fatalf("CalculateValuation failed: %v", err)
+		}
+		if resp.Result.Method != pb.ValuationMethod_VALUATION_METHOD_INCOME || resp.Result.Income.GetNetOperatingIncome() != 200000 {
+			t.Errorf("Unexpected income result: %v", resp.Result)
+		}
+
+		// Commercial property types may have no bedrooms or bathrooms
+		warehouse := testutil.CreateTestProperty()
+		warehouse.PropertyType = "warehouse"
+		warehouse.Bedrooms = 0
+		warehouse.Bathrooms = 0
+		resp, err = client.CalculateValuation(ctx, &pb.ValuationRequest{
+			Property: toProto(warehouse),
+			Method:   pb.ValuationMethod_VALUATION_METHOD_INCOME,
+			Income:   income,
+		})
+		if err != nil {
+			t.Fatalf("CalculateValuation of a warehouse without bedrooms failed: %v", err)
+		}
+		if resp.Result.Method != pb.ValuationMethod_VALUATION_METHOD_INCOME || resp.Result.Value <= 0 {
+			t.Errorf("Unexpected warehouse result: %v", resp.Result)
+		}
+
+		// Residential property types cannot use the income approach
+		property.PropertyType = "house"
+		_, err = client.CalculateValuation(ctx, &pb.ValuationRequest{
+			Property: toProto(property),
+			Method:   pb.ValuationMethod_VALUATION_METHOD_INCOME,
+			Income:   income,
+		})
+		st, _ := status.FromError(err)
+		if _, ok := fieldViolations(st)["method"]; st.Code() != codes.InvalidArgument || !ok {
+			t.Errorf("Expected method field violation, got %v", err)
+		}
+	})
+
+	t.Run("Reconciled Approaches", func(t *testing.T) {
+		property := testutil.CreateTestProperty()
+		property.PropertyType = "office_class_a"
+		resp, err := client.CalculateValuation(ctx, &pb.ValuationRequest{
+			Property: toProto(property),
+			Method:   pb.ValuationMethod_VALUATION_METHOD_RECONCILED,
+			Income: &pb.IncomeData{
+				RentRoll:          []*pb.Lease{{Tenant: "Acme Corp", AnnualRent: 300000}},
+				VacancyRate:       0.1,
+				OperatingExpenses: 70000,
+				CapRate:           0.08,
+			},
+		})
+		if err != nil {
+			t.Fatalf("CalculateValuation failed: %v", err)
+		}
+
+		// Sales comparison is not registered without a dataset, so the
+		// commercial weights are normalised over cost and income
+		result := resp.Result
+		weights := make(map[string]float64)
+		expected := 0.0
+		for _, approach := range result.Approaches {
+			weights[approach.Approach] = approach.Weight
+			expected += approach.Value * approach.Weight
+		}
+		if len(weights) != 2 || math.Abs(weights["income"]-0.8) > 0.0001 || math.Abs(weights["cost"]-0.2) > 0.0001 {
+			t.Errorf("Approach weights = %v, want income 0.8 and cost 0.2", weights)
+		}
+		if math.Abs(result.Value-expected) > 0.01 {
+			t.Errorf("Value = %.2f, want weighted value %.2f", result.Value, expected)
+		}
+		if result.Method != pb.ValuationMethod_VALUATION_METHOD_RECONCILED || result.Breakdown == nil || result.Income == nil {
+			t.Errorf("Expected reconciled result with cost breakdown and income analysis, got %v", result)
+		}
+	})
+
+	t.Run("Value Range", func(t *testing.T) {
+		property := testutil.CreateTestProperty()
+		resp, err := client.CalculateValuation(ctx, &pb.ValuationRequest{Property: toProto(property)})
+		if err != nil {
+			t.Fatalf("CalculateValuation failed: %v", err)
+		}
+		valueRange := resp.Result.ValueRange
+		if valueRange.GetMethod() != "analytical" || valueRange.GetConfidenceLevel() != 0.9 ||
+			valueRange.GetLow() >= resp.Result.Value || valueRange.GetHigh() <= resp.Result.Value {
+			t.Errorf("Unexpected default value range: %v", valueRange)
+		}
+
+		resp, err = client.CalculateValuation(ctx, &pb.ValuationRequest{
+			Property: toProto(property),
+			Interval: &pb.IntervalOptions{ConfidenceLevel: 0.8, MonteCarlo: true, Simulations: 500, Seed: 7},
+		})
+		if err != nil {
+			t.Fatalf("CalculateValuation failed: %v", err)
+		}
+		valueRange = resp.Result.ValueRange
+		if valueRange.GetMethod() != "monte_carlo" || len(valueRange.GetPercentiles()) == 0 || valueRange.GetLow() >= valueRange.GetHigh() {
+			t.Errorf("Unexpected simulated value range: %v", valueRange)
+		}
+
+		// Simulation perturbs cost approach inputs only
+		_, err = client.CalculateValuation(ctx, &pb.ValuationRequest{
+			Property: toProto(property),
+			Method:   pb.ValuationMethod_VALUATION_METHOD_RECONCILED,
+			Interval: &pb.IntervalOptions{MonteCarlo: true, ConfidenceLevel: 1.5},
+		})
+		st, _ := status.FromError(err)
+		violations := fieldViolations(st)
+		if _, ok := violations["interval.confidence_level"]; st.Code() != codes.InvalidArgument || !ok {
+			t.Errorf("Expected confidence level field violation, got %v", err)
+		}
+
+		_, err = client.CalculateValuation(ctx, &pb.ValuationRequest{
+			Property: toProto(property),
+			Method:   pb.ValuationMethod_VALUATION_METHOD_RECONCILED,
+			Interval: &pb.IntervalOptions{MonteCarlo: true},
+		})
+		st, _ = status.FromError(err)
+		if _, ok := fieldViolations(st)["interval.monte_carlo"]; st.Code() != codes.InvalidArgument || !ok {
+			t.Errorf("Expected monte carlo field violation, got %v", err)
+		}
+	})
+
+	t.Run("Sensitivity Analysis", func(t *testing.T) {
+		property := testutil.CreateTestProperty()
+		resp, err := client.CalculateValuation(ctx, &pb.ValuationRequest{
+			Property:    toProto(property),
+			Sensitivity: true,
+		})
+		if err != nil {
+			t.Fatalf("CalculateValuation failed: %v", err)
+		}
+		if len(resp.Result.Sensitivity) != 3+len(property.Features) {
+			t.Errorf("Got %d sensitivity factors, want %d", len(resp.Result.Sensitivity), 3+len(property.Features))
+		}
+
+		_, err = client.CalculateValuation(ctx, &pb.ValuationRequest{
+			Property:    toProto(property),
+			Method:      pb.ValuationMethod_VALUATION_METHOD_RECONCILED,
+			Sensitivity: true,
+		})
+		st, _ := status.FromError(err)
+		if _, ok := fieldViolations(st)["sensitivity"]; st.Code() != codes.InvalidArgument || !ok {
+			t.Errorf("Expected sensitivity field violation, got %v", err)
+		}
+	})
+
+	t.Run("Valuation Date", func(t *testing.T) {
+		property := testutil.CreateTestProperty()
+		valuationDate := time.Date(time.Now().Year()+1, 1, 1, 0, 0, 0, 0, time.UTC)
+		resp, err := client.CalculateValuation(ctx, &pb.ValuationRequest{
+			Property:      toProto(property),
+			ValuationDate: timestamppb.New(valuationDate),
+		})
+		if err != nil {
+			t.Fatalf("CalculateValuation failed: %v", err)
+		}
+		if !resp.Result.Breakdown.GetValuationDate().AsTime().Equal(valuationDate) {
+			t.Errorf("Breakdown valuation date = %v, want %v", resp.Result.Breakdown.GetValuationDate(), valuationDate)
+		}
+
+		_, err = client.CalculateValuation(ctx, &pb.ValuationRequest{
+			Property:      toProto(property),
+			ValuationDate: timestamppb.New(time.Date(1850, 1, 1, 0, 0, 0, 0, time.UTC)),
+		})
+		st, _ := status.FromError(err)
+		if _, ok := fieldViolations(st)["valuation_date"]; st.Code() != codes.InvalidArgument || !ok {
+			t.Errorf("Expected valuation date field violation, got %v", err)
+		}
+
+		_, err = client.CalculateValuation(ctx, &pb.ValuationRequest{
+			Property:      toProto(property),
+			ValuationDate: timestamppb.New(time.Date(property.YearBuilt-1, 6, 1, 0, 0, 0, 0, time.UTC)),
+		})
+		st, _ = status.FromError(err)
+		if _, ok := fieldViolations(st)["year_built"]; st.Code() != codes.InvalidArgument || !ok {
+			t.Errorf("Expected year built field violation, got %v", err)
+		}
+	})
+
+	// Test invalid properties
+	invalidProperties := testutil.CreateInvalidProperties()
+	for name, property := range invalidProperties {
+		t.Run(name, func(t *testing.T) {
+			_, err := client.CalculateValuation(ctx, &pb.ValuationRequest{
+				Property: toProto(property),
+			})
+
+			if err == nil {
+				t.Error("Expected error for invalid property, got nil")
+				return
+			}
+
+			st, ok := status.FromError(err)
+			if !ok {
+				t.Errorf("Expected gRPC status error, got %v", err)
+				return
+			}
+
+			if st.Code() != codes.InvalidArgument {
+				t.Errorf("Expected InvalidArgument code, got %v", st.Code())
+			}
+
+			if len(fieldViolations(st)) != 1 {
+				t.Errorf("Expected exactly one field violation, got %v", fieldViolations(st))
+			}
+		})
+	}
+
+	t.Run("Multiple Violations", func(t *testing.T) {
+		property := testutil.CreateTestProperty()
+		property.Bedrooms = 0
+		property.SquareFootage = -1
+		property.Condition = "pristine"
+
+		_, err := client.CalculateValuation(ctx, &pb.ValuationRequest{
+			Property: toProto(property),
+		})
+
+		st, _ := status.FromError(err)
+		if st.Code() != codes.InvalidArgument {
+			t.Fatalf("Expected InvalidArgument code, got %v", st.Code())
+		}
+
+		violations := fieldViolations(st)
+		for _, field := range []string{"bedrooms", "square_footage", "condition"} {
+			if _, ok := violations[field]; !ok {
+				t.Errorf("Expected field violation for %s, got %v", field, violations)
+			}
+		}
+		if len(violations) != 3 {
+			t.Errorf("Expected 3 field violations, got %d", len(violations))
+		}
+	})
+
+	t.Run("Missing Property", func(t *testing.T) {
+		_, err := client.CalculateValuation(ctx, &pb.ValuationRequest{})
+
+		st, _ := status.FromError(err)
+		if st.Code() != codes.InvalidArgument {
+			t.Fatalf("Expected InvalidArgument code, got %v", st.Code())
+		}
+		if _, ok := fieldViolations(st)["property"]; !ok {
+			t.Errorf("Expected field violation for property, got %v", fieldViolations(st))
+		}
+	})
+
+	// Test timeout
+	t.Run("Timeout", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(ctx, 1*time.Nanosecond)
+		defer cancel()
+
+		property := testutil.CreateTestProperty()
+		_, err := client.CalculateValuation(ctx, &pb.ValuationRequest{
+			Property: toProto(property),
+		})
+
+		if err == nil {
+			t.Error("Expected timeout error, got nil")
+			return
+		}
+
+		st, ok := status.FromError(err)
+		if !ok {
+			t.Errorf("Expected gRPC status error, got %v", err)
+			return
+		}
+
+		if st.Code() != codes.DeadlineExceeded {
+			t.Errorf("Expected DeadlineExceeded code, got %v", st.Code())
+		}
+	})
+}
+
+func TestValuationClock(t *testing.T) {
+	fake := clock.NewFake(time.Date(2026, 12, 31, 23, 0, 0, 0, time.UTC))
+	srv := newServer(4, 10)
+	srv.clock = fake
+	client := newTestClient(t, srv)
+	ctx := context.Background()
+
+	property := testutil.CreateTestProperty()
+	property.YearBuilt = 2027
+
+	_, err := client.CalculateValuation(ctx, &pb.ValuationRequest{Property: toProto(property)})
+	st, _ := status.FromError(err)
+	if _, ok := fieldViolations(st)["year_built"]; st.Code() != codes.InvalidArgument || !ok {
+		t.Errorf("Expected year built field violation before New Year, got %v", err)
+	}
+
+	// The server picks up the new year without a restart
+	fake.Advance(2 * time.Hour)
+	resp, err := client.CalculateValuation(ctx, &pb.ValuationRequest{Property: toProto(property)})
+	if err != nil {
+		t.Fatalf("CalculateValuation failed after New Year: %v", err)
+	}
+	if got := resp.Result.Breakdown.GetValuationDate().AsTime(); !got.Equal(fake.Now()) {
+		t.Errorf("Breakdown valuation date = %v, want %v", got, fake.Now())
+	}
+
+	_, err = client.CalculateValuation(ctx, &pb.ValuationRequest{
+		Property:      toProto(property),
+		ValuationDate: timestamppb.New(fake.Now().AddDate(11, 0, 0)),
+	})
+	st, _ = status.FromError(err)
+	if _, ok := fieldViolations(st)["valuation_date"]; st.Code() != codes.InvalidArgument || !ok {
+		t.Errorf("Expected valuation date field violation, got %v", err)
+	}
+}
+
+func startTestServer(t *testing.T, srv *server) (*grpc.Server, string) {
+	lis, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+
+	s := grpc.NewServer()
+	pb.RegisterValuationServiceServer(s, srv)
+
+	go func() {
+		if err := s.Serve(lis); err != nil {
+			t.Errorf("Failed to serve: %v", err)
+		}
+	}()
+
+	return s, lis.Addr().String()
+}
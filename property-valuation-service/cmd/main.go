@@ -1,7 +0,0 @@
-package main
-
-import "fmt"
-
-func main() {
-    fmt.Println("Hello, World! This is the Property Valuation Microservice.")
-}
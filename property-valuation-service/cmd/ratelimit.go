@@ -0,0 +1,174 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net"
+	"slices"
+	"strings"
+	"time"
+
+	pb "github.com/jsarcade/property-valuation-service/proto"
+	"github.com/jsarcade/property-valuation-service/pkg/errors"
+	"github.com/jsarcade/property-valuation-service/pkg/quota"
+	"github.com/jsarcade/property-valuation-service/pkg/ratelimit"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// errUsageDisabled is returned by ListUsage when no quota store is configured
+var errUsageDisabled = status.Error(codes.FailedPrecondition, "usage is not counted: configure quota-db")
+
+// unlimitedMethods are the method prefixes that neither take tokens nor count
+// towards quotas, so that load balancers and tools are never throttled
+var unlimitedMethods = []string{"/grpc.health.v1.Health/", "/grpc.reflection."}
+
+// setupRateLimits loads the configured rate limits and opens the quota store.
+// Requests are neither limited nor counted when neither is configured.
+func (a *app) setupRateLimits() error {
+	if a.cfg.RateLimits == "" && a.cfg.QuotaDB == "" {
+		return nil
+	}
+
+	var limits ratelimit.Config
+	if a.cfg.RateLimits != "" {
+		var err error
+		limits, err = ratelimit.LoadConfig(a.cfg.RateLimits)
+		if err != nil {
+			return err
+		}
+		for name := range limits.Methods {
+			if !isServiceMethod(name) {
+				return fmt.Errorf("invalid rate limits %s: unknown RPC %q", a.cfg.RateLimits, name)
+			}
+		}
+		if limits.MonthlyQuota > 0 && a.cfg.QuotaDB == "" {
+			return fmt.Errorf("invalid rate limits %s: monthlyQuota requires quota-db", a.cfg.RateLimits)
+		}
+	}
+	a.limiter = ratelimit.NewLimiter(limits, a.srv.clock)
+	a.srv.monthlyQuota = limits.MonthlyQuota
+
+	if a.cfg.QuotaDB != "" {
+		store, err := quota.Open(a.cfg.QuotaDB, a.srv.clock)
+		if err != nil {
+			return err
+		}
+		a.closers = append(a.closers, store.Close)
+		a.srv.quota = store
+		a.logger.Info("counting monthly usage", "path", a.cfg.QuotaDB, "monthly_quota", limits.MonthlyQuota)
+	}
+	if a.cfg.RateLimits != "" {
+		a.logger.Info("rate limiting enabled", "path", a.cfg.RateLimits)
+	}
+	return nil
+}
+
+// isServiceMethod reports whether name is an RPC of the valuation service
+func isServiceMethod(name string) bool {
+	desc := pb.ValuationService_ServiceDesc
+	return slices.ContainsFunc(desc.Methods, func(m grpc.MethodDesc) bool { return m.MethodName == name }) ||
+		slices.ContainsFunc(desc.Streams, func(s grpc.StreamDesc) bool { return s.StreamName == name })
+}
+
+// rateLimitInterceptors returns the interceptors limiting every RPC, or none when
+// rate limiting and quotas are disabled
+func (a *app) rateLimitInterceptors() ([]grpc.UnaryServerInterceptor, []grpc.StreamServerInterceptor) {
+	if a.limiter == nil {
+		return nil, nil
+	}
+	opts := ratelimit.Options{
+		Client: rateLimitClient,
+		Cost:   valuationCost,
+		Served: valuationsServed,
+		Skip:   unlimitedMethods,
+		Quota:  a.srv.quota,
+	}
+	return []grpc.UnaryServerInterceptor{ratelimit.UnaryServerInterceptor(a.limiter, opts)},
+		[]grpc.StreamServerInterceptor{ratelimit.StreamServerInterceptor(a.limiter, opts)}
+}
+
+// rateLimitClient identifies the client a request is limited and billed as: the
+// authenticated caller, or the IP address of anonymous callers. REST requests reach
+// the service through the in-memory connection of the gateway, so their address is
+// the last one the gateway appended to x-forwarded-for.
+func rateLimitClient(ctx context.Context) string {
+	if name := principalName(ctx); name != "" {
+		return name
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return "unknown"
+	}
+	if p.Addr.Network() == "bufconn" {
+		if forwarded := metadata.ValueFromIncomingContext(ctx, "x-forwarded-for"); len(forwarded) > 0 {
+			hops := strings.Split(forwarded[len(forwarded)-1], ",")
+			return strings.TrimSpace(hops[len(hops)-1])
+		}
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
+// valuationCost returns the number of valuations a request message asks for: one
+// per item of a batch and one for any other message
+func valuationCost(req any) int {
+	if batch, ok := req.(*pb.BatchValuationRequest); ok {
+		return len(batch.GetRequests())
+	}
+	return 1
+}
+
+// valuationsServed returns the number of valuations a response message delivers:
+// one per successful item of a batch or stream and one for any other message
+func valuationsServed(resp any) int {
+	switch resp := resp.(type) {
+	case *pb.BatchValuationResponse:
+		served := 0
+		for _, item := range resp.GetItems() {
+			if item.GetResult() != nil {
+				served++
+			}
+		}
+		return served
+	case *pb.ValuationItem:
+		if resp.GetResult() == nil {
+			return 0
+		}
+	}
+	return 1
+}
+
+func (s *server) ListUsage(ctx context.Context, req *pb.ListUsageRequest) (*pb.ListUsageResponse, error) {
+	if s.quota == nil {
+		return nil, errUsageDisabled
+	}
+	month := req.GetMonth()
+	if month == "" {
+		month = quota.Month(s.clock.Now())
+	} else if _, err := time.Parse(quota.MonthFormat, month); err != nil {
+		return nil, errors.ConvertToGRPCError(&errors.ValidationError{Field: "month", Message: errors.ErrInvalidMonth})
+	}
+
+	usage, err := s.quota.Usage(month)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to read usage", "month", month, "error", err)
+		return nil, status.Error(codes.Internal, "failed to read usage")
+	}
+	resp := &pb.ListUsageResponse{Month: month, MonthlyQuota: s.monthlyQuota}
+	for _, client := range slices.Sorted(maps.Keys(usage)) {
+		resp.Usage = append(resp.Usage, &pb.ClientUsage{
+			Client:     client,
+			Requests:   usage[client].Requests,
+			Valuations: usage[client].Units,
+		})
+	}
+	return resp, nil
+}
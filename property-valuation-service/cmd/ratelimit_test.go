@@ -0,0 +1,295 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jsarcade/property-valuation-service/pkg/auth"
+	"github.com/jsarcade/property-valuation-service/pkg/clock"
+	"github.com/jsarcade/property-valuation-service/pkg/ratelimit"
+	"github.com/jsarcade/property-valuation-service/pkg/testutil"
+	pb "github.com/jsarcade/property-valuation-service/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// startRateLimited starts a server authenticating the API keys crm-key,
+// portal-key and admin-key and enforcing the given rate limits, on a fixed
+// clock that keeps buckets from refilling while the test runs
+func startRateLimited(t *testing.T, limits string) (*app, *grpc.ClientConn, *clock.Fake) {
+	t.Helper()
+	dir := t.TempDir()
+	keys := filepath.Join(dir, "api_keys.yaml")
+	content := "keys:\n" +
+		"  - {name: crm, hash: " + auth.HashAPIKey("crm-key") + ", role: appraiser}\n" +
+		"  - {name: portal, hash: " + auth.HashAPIKey("portal-key") + ", role: appraiser}\n" +
+		"  - {name: ops, hash: " + auth.HashAPIKey("admin-key") + ", role: admin}\n"
+	if err := os.WriteFile(keys, []byte(content), 0o600); err != nil {
+		t.Fatalf("Failed to write API keys: %v", err)
+	}
+	path := filepath.Join(dir, "rate_limits.yaml")
+	if err := os.WriteFile(path, []byte(limits), 0o644); err != nil {
+		t.Fatalf("Failed to write rate limits: %v", err)
+	}
+
+	cfg := defaultConfig()
+	cfg.APIKeys = keys
+	cfg.RateLimits = path
+	cfg.QuotaDB = filepath.Join(dir, "quota.db")
+	a := &app{cfg: cfg, srv: newServer(2, 10), health: health.NewServer(), logger: discardLogger}
+	a.srv.logger = discardLogger
+	clk := clock.NewFake(time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC))
+	a.srv.clock = clk
+	if err := a.setupAuth(); err != nil {
+		t.Fatalf("setupAuth failed: %v", err)
+	}
+	if err := a.setupRateLimits(); err != nil {
+		t.Fatalf("setupRateLimits failed: %v", err)
+	}
+	t.Cleanup(a.close)
+
+	grpcServer := a.newGRPCServer()
+	lis, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	go grpcServer.Serve(lis)
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("Failed to connect to server: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return a, conn, clk
+}
+
+func TestRateLimits(t *testing.T) {
+	a, conn, clk := startRateLimited(t, "default: {rate: 1, burst: 3}\n"+
+		"methods:\n  ListUsage: {}\n"+
+		"exempt: [api_key:ops]\n"+
+		"monthlyQuota: 6\n")
+	client := pb.NewValuationServiceClient(conn)
+	property := toProto(testutil.CreateTestProperty())
+
+	calculate := func(key string) (metadata.MD, error) {
+		var header metadata.MD
+		ctx := metadata.AppendToOutgoingContext(context.Background(), auth.APIKeyKey, key)
+		_, err := client.CalculateValuation(ctx, &pb.ValuationRequest{Property: property}, grpc.Header(&header))
+		return header, err
+	}
+	batch := func(key string, size int) error {
+		req := &pb.BatchValuationRequest{}
+		for range size {
+			req.Requests = append(req.Requests, &pb.ValuationRequest{Property: property})
+		}
+		ctx := metadata.AppendToOutgoingContext(context.Background(), auth.APIKeyKey, key)
+		_, err := client.BatchCalculateValuation(ctx, req)
+		return err
+	}
+
+	// The burst is shared by valuations of an RPC, whether requested alone or in batches
+	if _, err := calculate("crm-key"); err != nil {
+		t.Fatalf("First valuation failed: %v", err)
+	}
+	if err := batch("crm-key", 3); err != nil {
+		t.Fatalf("Batch within the burst failed: %v", err)
+	}
+	if err := batch("crm-key", 1); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Batch over the rate limit = %v, want ResourceExhausted", err)
+	}
+	if err := batch("crm-key", 4); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Batch over the burst = %v, want ResourceExhausted", err)
+	}
+	if _, err := calculate("crm-key"); err != nil {
+		t.Fatalf("Second valuation failed: %v", err)
+	}
+	if _, err := calculate("crm-key"); err != nil {
+		t.Fatalf("Third valuation failed: %v", err)
+	}
+	header, err := calculate("crm-key")
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("Valuation over the rate limit = %v, want ResourceExhausted", err)
+	}
+	if got := header.Get(ratelimit.RetryAfterKey); len(got) != 1 || got[0] != "1" {
+		t.Errorf("Retry-after = %v, want [1]", got)
+	}
+
+	// Other clients have their own buckets and exempt clients are never limited
+	if _, err := calculate("portal-key"); err != nil {
+		t.Errorf("Valuation of another client failed: %v", err)
+	}
+	// Only the valuations served are billed: none for a failed request, one per successful batch item
+	portal := metadata.AppendToOutgoingContext(context.Background(), auth.APIKeyKey, "portal-key")
+	if _, err := client.CalculateValuation(portal, &pb.ValuationRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Valuation without a property = %v, want InvalidArgument", err)
+	}
+	resp, err := client.BatchCalculateValuation(portal, &pb.BatchValuationRequest{
+		Requests: []*pb.ValuationRequest{{Property: property}, {}},
+	})
+	if err != nil || resp.GetItems()[1].GetError() == nil {
+		t.Errorf("Batch with an invalid item = %v, %v, want an item error", resp, err)
+	}
+	for range 5 {
+		if _, err := calculate("admin-key"); err != nil {
+			t.Fatalf("Valuation of an exempt client failed: %v", err)
+		}
+	}
+
+	// crm has now requested the 6 valuations of its monthly quota
+	clk.Advance(time.Minute)
+	if _, err := calculate("crm-key"); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Valuation over the monthly quota = %v, want ResourceExhausted", err)
+	}
+
+	t.Run("REST Gateway", func(t *testing.T) {
+		gateway, err := newGateway(context.Background(), conn)
+		if err != nil {
+			t.Fatalf("Failed to create gateway: %v", err)
+		}
+		httpServer := httptest.NewServer(gateway)
+		defer httpServer.Close()
+
+		body, err := protojson.Marshal(&pb.ValuationRequest{Property: property})
+		if err != nil {
+			t.Fatalf("Failed to marshal request: %v", err)
+		}
+		req, _ := http.NewRequest(http.MethodPost, httpServer.URL+"/v1/valuations:calculate", bytes.NewReader(body))
+		req.Header.Set("X-Api-Key", "crm-key")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("POST failed: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusTooManyRequests {
+			t.Errorf("Status = %d, want %d", resp.StatusCode, http.StatusTooManyRequests)
+		}
+		// The quota of March resets on April 1st, 21 days, 14 hours and 59 minutes later
+		if got, want := resp.Header.Get("Retry-After"), "1868340"; got != want {
+			t.Errorf("Retry-After = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("ListUsage", func(t *testing.T) {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), auth.APIKeyKey, "admin-key")
+		resp, err := client.ListUsage(ctx, &pb.ListUsageRequest{})
+		if err != nil {
+			t.Fatalf("ListUsage failed: %v", err)
+		}
+		if resp.GetMonth() != "2026-03" || resp.GetMonthlyQuota() != 6 {
+			t.Errorf("Month = %s with quota %d, want 2026-03 with quota 6", resp.GetMonth(), resp.GetMonthlyQuota())
+		}
+		// Usage includes the unit this request of ops reserves until it is answered
+		want := map[string][2]int64{"api_key:crm": {4, 6}, "api_key:ops": {5, 6}, "api_key:portal": {2, 2}}
+		if len(resp.GetUsage()) != len(want) {
+			t.Fatalf("Usage = %v, want %d clients", resp.GetUsage(), len(want))
+		}
+		for _, usage := range resp.GetUsage() {
+			if got := [2]int64{usage.GetRequests(), usage.GetValuations()}; got != want[usage.GetClient()] {
+				t.Errorf("Usage of %s = %v requests and valuations, want %v", usage.GetClient(), got, want[usage.GetClient()])
+			}
+		}
+
+		_, err = client.ListUsage(ctx, &pb.ListUsageRequest{Month: "March"})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("ListUsage of an invalid month = %v, want InvalidArgument", err)
+		}
+		ctx = metadata.AppendToOutgoingContext(context.Background(), auth.APIKeyKey, "crm-key")
+		if _, err := client.ListUsage(ctx, &pb.ListUsageRequest{}); status.Code(err) != codes.PermissionDenied {
+			t.Errorf("ListUsage as appraiser = %v, want PermissionDenied", err)
+		}
+	})
+
+	// Rejected requests are counted as errors of the RPCs they were made to
+	recorder := httptest.NewRecorder()
+	a.srv.metrics.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	for _, want := range []string{
+		`valuation_rpc_errors_total{code="ResourceExhausted",method="/valuation.ValuationService/CalculateValuation"}`,
+		`valuation_rpc_errors_total{code="PermissionDenied",method="/valuation.ValuationService/ListUsage"}`,
+	} {
+		if !strings.Contains(recorder.Body.String(), want) {
+			t.Errorf("Metrics do not contain %s", want)
+		}
+	}
+}
+
+func TestStreamRateLimits(t *testing.T) {
+	a, conn, clk := startRateLimited(t, "default: {rate: 1, burst: 3}\nmonthlyQuota: 4\n")
+	client := pb.NewValuationServiceClient(conn)
+	ctx := metadata.AppendToOutgoingContext(context.Background(), auth.APIKeyKey, "crm-key")
+	valid := &pb.ValuationRequest{Property: toProto(testutil.CreateTestProperty())}
+
+	// exchange sends each request and waits for its item, returning the error
+	// ending the stream if any
+	exchange := func(stream pb.ValuationService_StreamValuationsClient, requests ...*pb.ValuationRequest) error {
+		for _, req := range requests {
+			if err := stream.Send(req); err != nil {
+				return err
+			}
+			if _, err := stream.Recv(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	usage := func() [2]int64 {
+		t.Helper()
+		usage, err := a.srv.quota.Usage("2026-03")
+		if err != nil {
+			t.Fatalf("Usage failed: %v", err)
+		}
+		return [2]int64{usage["api_key:crm"].Requests, usage["api_key:crm"].Units}
+	}
+
+	// Every message takes a token; only the items served are billed
+	stream, err := client.StreamValuations(ctx)
+	if err != nil {
+		t.Fatalf("StreamValuations failed: %v", err)
+	}
+	if err := exchange(stream, valid, &pb.ValuationRequest{}, valid); err != nil {
+		t.Fatalf("Messages within the burst failed: %v", err)
+	}
+	if err := exchange(stream, valid); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("Message over the rate limit = %v, want ResourceExhausted", err)
+	}
+	if got, want := usage(), [2]int64{2, 2}; got != want {
+		t.Errorf("Usage = %v requests and valuations, want %v", got, want)
+	}
+
+	// The quota ends the stream once the items served reach it
+	clk.Advance(time.Minute)
+	stream, err = client.StreamValuations(ctx)
+	if err != nil {
+		t.Fatalf("StreamValuations failed: %v", err)
+	}
+	if err := exchange(stream, valid, valid); err != nil {
+		t.Fatalf("Messages within the quota failed: %v", err)
+	}
+	if err := exchange(stream, valid); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("Message over the monthly quota = %v, want ResourceExhausted", err)
+	}
+	if got, want := usage(), [2]int64{4, 4}; got != want {
+		t.Errorf("Usage = %v requests and valuations, want %v", got, want)
+	}
+}
+
+func TestListUsageDisabled(t *testing.T) {
+	srv := newServer(1, 1)
+	_, err := srv.ListUsage(context.Background(), &pb.ListUsageRequest{})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("ListUsage error = %v, want FailedPrecondition", err)
+	}
+}
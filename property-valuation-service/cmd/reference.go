@@ -0,0 +1,74 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"maps"
+	"slices"
+
+	pb "github.com/jsarcade/property-valuation-service/proto"
+	"github.com/jsarcade/property-valuation-service/pkg/income"
+	"github.com/jsarcade/property-valuation-service/pkg/validation"
+)
+
+func (s *server) ListPropertyTypes(ctx context.Context, req *pb.ListPropertyTypesRequest) (*pb.ListPropertyTypesResponse, error) {
+	model := s.pricingModel(ctx)
+	resp := &pb.ListPropertyTypesResponse{ModelVersion: model.Version}
+	for _, name := range slices.Sorted(maps.Keys(model.BasePricePerSquareFoot)) {
+		resp.PropertyTypes = append(resp.PropertyTypes, &pb.PropertyType{
+			Name:               name,
+			PricePerSquareFoot: model.BasePricePerSquareFoot[name],
+			IncomeApproach:     income.IsIncomeProperty(name),
+		})
+	}
+	return resp, nil
+}
+
+func (s *server) ListConditions(ctx context.Context, req *pb.ListConditionsRequest) (*pb.ListConditionsResponse, error) {
+	model := s.pricingModel(ctx)
+	resp := &pb.ListConditionsResponse{
+		MaintenanceLevels:  validation.MaintenanceLevels,
+		RenovationStatuses: validation.RenovationStatuses,
+		ModelVersion:       model.Version,
+	}
+
+	// Best condition first, as in a dropdown
+	names := slices.Sorted(maps.Keys(model.ConditionCriteria))
+	slices.SortStableFunc(names, func(a, b string) int {
+		return cmp.Compare(model.ConditionCriteria[b].Multiplier, model.ConditionCriteria[a].Multiplier)
+	})
+	for _, name := range names {
+		condition := model.ConditionCriteria[name]
+		resp.Conditions = append(resp.Conditions, &pb.Condition{
+			Name:             name,
+			Multiplier:       condition.Multiplier,
+			Description:      condition.Description,
+			Criteria:         condition.Criteria,
+			MinAge:           int32(condition.MinAge),
+			MaxAge:           int32(condition.MaxAge),
+			RequiredFeatures: condition.RequiredFeatures,
+			ExcludedFeatures: condition.ExcludedFeatures,
+			MaintenanceLevel: condition.MaintenanceLevel,
+			RenovationStatus: condition.RenovationStatus,
+		})
+	}
+	return resp, nil
+}
+
+func (s *server) ListFeatures(ctx context.Context, req *pb.ListFeaturesRequest) (*pb.ListFeaturesResponse, error) {
+	model := s.pricingModel(ctx)
+	resp := &pb.ListFeaturesResponse{ModelVersion: model.Version}
+	for _, name := range slices.Sorted(maps.Keys(model.FeatureValue)) {
+		resp.Features = append(resp.Features, &pb.Feature{Name: name, Value: model.FeatureValue[name]})
+	}
+	return resp, nil
+}
+
+func (s *server) ListLocationClasses(ctx context.Context, req *pb.ListLocationClassesRequest) (*pb.ListLocationClassesResponse, error) {
+	model := s.pricingModel(ctx)
+	resp := &pb.ListLocationClassesResponse{ModelVersion: model.Version}
+	for _, name := range slices.Sorted(maps.Keys(model.LocationMultiplier)) {
+		resp.LocationClasses = append(resp.LocationClasses, &pb.LocationClass{Name: name, Multiplier: model.LocationMultiplier[name]})
+	}
+	return resp, nil
+}
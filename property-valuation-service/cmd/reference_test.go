@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/jsarcade/property-valuation-service/pkg/income"
+	"github.com/jsarcade/property-valuation-service/pkg/valuation"
+	pb "github.com/jsarcade/property-valuation-service/proto"
+)
+
+func TestReferenceData(t *testing.T) {
+	client := newTestClient(t, newServer(4, 10))
+	ctx := context.Background()
+
+	t.Run("Property Types", func(t *testing.T) {
+		resp, err := client.ListPropertyTypes(ctx, &pb.ListPropertyTypesRequest{})
+		if err != nil {
+			t.Fatalf("ListPropertyTypes failed: %v", err)
+		}
+		if len(resp.PropertyTypes) != len(valuation.BasePricePerSquareFoot) {
+			t.Fatalf("Got %d property types, want %d", len(resp.PropertyTypes), len(valuation.BasePricePerSquareFoot))
+		}
+		if !slices.IsSortedFunc(resp.PropertyTypes, func(a, b *pb.PropertyType) int {
+			return strings.Compare(a.Name, b.Name)
+		}) {
+			t.Errorf("Property types are not sorted by name")
+		}
+		for _, propertyType := range resp.PropertyTypes {
+			if propertyType.PricePerSquareFoot != valuation.BasePricePerSquareFoot[propertyType.Name] {
+				t.Errorf("%s price = %.2f, want %.2f", propertyType.Name, propertyType.PricePerSquareFoot,
+					valuation.BasePricePerSquareFoot[propertyType.Name])
+			}
+			if want := income.IsIncomeProperty(propertyType.Name); propertyType.IncomeApproach != want {
+				t.Errorf("%s income approach = %v, want %v", propertyType.Name, propertyType.IncomeApproach, want)
+			}
+		}
+	})
+
+	t.Run("Conditions", func(t *testing.T) {
+		resp, err := client.ListConditions(ctx, &pb.ListConditionsRequest{})
+		if err != nil {
+			t.Fatalf("ListConditions failed: %v", err)
+		}
+		if len(resp.Conditions) != len(valuation.ConditionCriteria) {
+			t.Fatalf("Got %d conditions, want %d", len(resp.Conditions), len(valuation.ConditionCriteria))
+		}
+		if first, last := resp.Conditions[0], resp.Conditions[len(resp.Conditions)-1]; first.Name != "excellent" || last.Name != "needs_work" {
+			t.Errorf("Conditions run from %s to %s, want excellent to needs_work", first.Name, last.Name)
+		}
+		excellent := resp.Conditions[0]
+		if !slices.Equal(excellent.RequiredFeatures, valuation.ConditionCriteria["excellent"].RequiredFeatures) ||
+			len(excellent.Criteria) == 0 || excellent.MaxAge != 2 {
+			t.Errorf("Excellent condition = %v, want its criteria, required features and age window", excellent)
+		}
+		if !slices.Contains(resp.MaintenanceLevels, "very_poor") || !slices.Contains(resp.RenovationStatuses, "needs_renovation") {
+			t.Errorf("Maintenance levels %v and renovation statuses %v are incomplete", resp.MaintenanceLevels, resp.RenovationStatuses)
+		}
+	})
+
+	t.Run("Features And Location Classes", func(t *testing.T) {
+		features, err := client.ListFeatures(ctx, &pb.ListFeaturesRequest{})
+		if err != nil {
+			t.Fatalf("ListFeatures failed: %v", err)
+		}
+		if len(features.Features) != len(valuation.FeatureValue) {
+			t.Errorf("Got %d features, want %d", len(features.Features), len(valuation.FeatureValue))
+		}
+
+		classes, err := client.ListLocationClasses(ctx, &pb.ListLocationClassesRequest{})
+		if err != nil {
+			t.Fatalf("ListLocationClasses failed: %v", err)
+		}
+		if len(classes.LocationClasses) != len(valuation.LocationMultiplier) {
+			t.Errorf("Got %d location classes, want %d", len(classes.LocationClasses), len(valuation.LocationMultiplier))
+		}
+	})
+
+	t.Run("Reloaded Model", func(t *testing.T) {
+		previous := valuation.ActiveModel()
+		defer valuation.SetActiveModel(previous)
+
+		model := valuation.BuiltinModel()
+		model.Version = "reloaded"
+		model.FeatureValue = map[string]float64{"solar_panels": 12000}
+		valuation.SetActiveModel(model)
+
+		resp, err := client.ListFeatures(ctx, &pb.ListFeaturesRequest{})
+		if err != nil {
+			t.Fatalf("ListFeatures failed: %v", err)
+		}
+		if resp.ModelVersion != "reloaded" || len(resp.Features) != 1 || resp.Features[0].Value != 12000 {
+			t.Errorf("Features = %v (model %s), want the reloaded table", resp.Features, resp.ModelVersion)
+		}
+	})
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/jsarcade/property-valuation-service/proto"
+	"github.com/jsarcade/property-valuation-service/pkg/errors"
+	"github.com/jsarcade/property-valuation-service/pkg/validation"
+	"github.com/jsarcade/property-valuation-service/pkg/valuation"
+)
+
+func (s *server) SimulateScenarios(ctx context.Context, req *pb.ScenarioRequest) (resp *pb.ScenarioResponse, err error) {
+	defer func() { s.auditScenarios(ctx, req, resp, err) }()
+
+	model := s.pricingModel(ctx)
+	now := s.clock.Now()
+	property, err := propertyFromProto(model, req.GetProperty(), now)
+	if err != nil {
+		return nil, err
+	}
+
+	scenarios := scenariosFromProto(req.GetScenarios())
+	if err := validation.ValidateScenarios(model, property, scenarios, now); err != nil {
+		return nil, errors.ConvertToGRPCError(err)
+	}
+
+	analysis := model.SimulateScenarios(property, scenarios, now)
+	resp = &pb.ScenarioResponse{
+		BaseValue:     analysis.BaseValue,
+		BaseBreakdown: breakdownToProto(analysis.BaseBreakdown),
+		ModelVersion:  model.Version,
+		TenantId:      tenantID(ctx),
+	}
+	for _, result := range analysis.Scenarios {
+		impacts := make([]*pb.ModificationImpact, 0, len(result.Impacts))
+		for _, impact := range result.Impacts {
+			impacts = append(impacts, &pb.ModificationImpact{
+				Description: impact.Description,
+				Cost:        impact.Cost,
+				ValueDelta:  impact.ValueDelta,
+			})
+		}
+
+		resp.Scenarios = append(resp.Scenarios, &pb.ScenarioResult{
+			Name:       result.Name,
+			Property:   propertyToProto(result.Property),
+			Value:      result.Value,
+			ValueDelta: result.ValueDelta,
+			Cost:       result.Cost,
+			Roi:        result.ROI,
+			Impacts:    impacts,
+			Breakdown:  breakdownToProto(result.Breakdown),
+		})
+	}
+	return resp, nil
+}
+
+// scenariosFromProto converts what-if scenarios received over gRPC, naming unnamed scenarios by position
+func scenariosFromProto(scenarios []*pb.Scenario) []valuation.Scenario {
+	result := make([]valuation.Scenario, 0, len(scenarios))
+	for i, scenario := range scenarios {
+		name := scenario.GetName()
+		if name == "" {
+			name = fmt.Sprintf("scenario %d", i+1)
+		}
+
+		modifications := make([]valuation.Modification, 0, len(scenario.GetModifications()))
+		for _, m := range scenario.GetModifications() {
+			modifications = append(modifications, valuation.Modification{
+				AddFeature:       m.GetAddFeature(),
+				RemoveFeature:    m.GetRemoveFeature(),
+				Condition:        m.GetCondition(),
+				MaintenanceLevel: m.GetMaintenanceLevel(),
+				RenovationStatus: m.GetRenovationStatus(),
+				AddSquareFootage: int(m.GetAddSquareFootage()),
+				Cost:             m.GetCost(),
+			})
+		}
+		result = append(result, valuation.Scenario{Name: name, Modifications: modifications})
+	}
+	return result
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jsarcade/property-valuation-service/pkg/testutil"
+	pb "github.com/jsarcade/property-valuation-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSimulateScenarios(t *testing.T) {
+	client := newTestClient(t, newServer(4, 10))
+	ctx := context.Background()
+
+	property := testutil.CreateTestProperty()
+	property.Condition = "fair"
+
+	t.Run("Valid Scenarios", func(t *testing.T) {
+		resp, err := client.SimulateScenarios(ctx, &pb.ScenarioRequest{
+			Property: toProto(property),
+			Scenarios: []*pb.Scenario{
+				{Name: "Solar and repairs", Modifications: []*pb.Modification{
+					{Change: &pb.Modification_AddFeature{AddFeature: "solar_panels"}, Cost: 20000},
+					{Change: &pb.Modification_Condition{Condition: "good"}, Cost: 30000},
+				}},
+				{Modifications: []*pb.Modification{
+					{Change: &pb.Modification_AddSquareFootage{AddSquareFootage: 300}, Cost: 90000},
+				}},
+			},
+		})
+		if err != nil {
+			t.Fatalf("SimulateScenarios failed: %v", err)
+		}
+		if resp.BaseValue <= 0 || len(resp.Scenarios) != 2 {
+			t.Fatalf("Unexpected response: %v", resp)
+		}
+
+		solar := resp.Scenarios[0]
+		if solar.Cost != 50000 || solar.ValueDelta <= 0 || len(solar.Impacts) != 2 || solar.Property.Condition != "good" {
+			t.Errorf("Unexpected scenario result: %v", solar)
+		}
+		if resp.Scenarios[1].Name != "scenario 2" || resp.Scenarios[1].Property.SquareFootage != 2300 {
+			t.Errorf("Unexpected scenario result: %v", resp.Scenarios[1])
+		}
+	})
+
+	t.Run("Invalid Modifications", func(t *testing.T) {
+		_, err := client.SimulateScenarios(ctx, &pb.ScenarioRequest{
+			Property: toProto(property),
+			Scenarios: []*pb.Scenario{
+				{Modifications: []*pb.Modification{
+					{Change: &pb.Modification_AddFeature{AddFeature: "garage"}},
+					{Change: &pb.Modification_RemoveFeature{RemoveFeature: "pool"}},
+					{Change: &pb.Modification_Condition{Condition: "pristine"}, Cost: -1},
+					{Cost: 100},
+				}},
+			},
+		})
+		st, _ := status.FromError(err)
+		if st.Code() != codes.InvalidArgument {
+			t.Fatalf("Expected InvalidArgument, got %v", err)
+		}
+
+		violations := fieldViolations(st)
+		for _, field := range []string{
+			"scenarios[0].modifications[0].add_feature",
+			"scenarios[0].modifications[1].remove_feature",
+			"scenarios[0].modifications[2].cost",
+			"scenarios[0].modifications[3]",
+			"scenarios[0].property.condition",
+		} {
+			if _, ok := violations[field]; !ok {
+				t.Errorf("Expected violation for %s, got %v", field, violations)
+			}
+		}
+	})
+}
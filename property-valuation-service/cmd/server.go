@@ -2,56 +2,486 @@ package main
 
 import (
 	"context"
+	stderrors "errors"
+	"flag"
 	"fmt"
-	"log"
-	"net"
+	"log/slog"
+	"os"
+	"os/signal"
+	"runtime"
+	"strings"
+	"syscall"
+	"time"
 
 	pb "github.com/jsarcade/property-valuation-service/proto"
+	"github.com/jsarcade/property-valuation-service/pkg/approaches"
+	"github.com/jsarcade/property-valuation-service/pkg/audit"
+	"github.com/jsarcade/property-valuation-service/pkg/clock"
+	"github.com/jsarcade/property-valuation-service/pkg/comparables"
+	"github.com/jsarcade/property-valuation-service/pkg/errors"
+	"github.com/jsarcade/property-valuation-service/pkg/history"
+	"github.com/jsarcade/property-valuation-service/pkg/income"
+	"github.com/jsarcade/property-valuation-service/pkg/logging"
+	"github.com/jsarcade/property-valuation-service/pkg/metrics"
+	"github.com/jsarcade/property-valuation-service/pkg/quota"
+	"github.com/jsarcade/property-valuation-service/pkg/tenant"
+	"github.com/jsarcade/property-valuation-service/pkg/tracing"
+	"github.com/jsarcade/property-valuation-service/pkg/validation"
 	"github.com/jsarcade/property-valuation-service/pkg/valuation"
-	"google.golang.org/grpc"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type server struct {
 	pb.UnimplementedValuationServiceServer
+
+	workers      int // Maximum number of properties valued concurrently per batch or stream
+	maxBatchSize int // Maximum number of requests accepted in a single batch
+
+	valuers  *valuation.Registry // Valuation approaches; sales comparison is only registered when a sales dataset is loaded
+	history  *history.Store      // Valuation history; nil when history is disabled
+	clock    clock.Clock         // Current time used to default and validate valuation dates
+	metrics  *metrics.Metrics    // RPC and valuation metrics exposed on /metrics
+	logger   *slog.Logger
+	auditLog *audit.Log // Audit trail of valuation requests; nil when auditing is disabled
+
+	tenants *tenant.Store // Pricing overrides of every tenant; nil when tenants use the base model
+
+	quota        *quota.Store // Monthly usage of every client; nil when usage is not counted
+	monthlyQuota int64        // Valuations each client may request per month; 0 when unlimited
+
+	// reloadModel reloads the pricing model file on request; nil when the model is not loaded from a file
+	reloadModel func() (*valuation.PricingModel, error)
 }
 
-func (s *server) CalculateValuation(ctx context.Context, req *pb.ValuationRequest) (*pb.ValuationResponse, error) {
+// newServer creates a valuation server, falling back to defaults for non-positive limits
+func newServer(workers, maxBatchSize int) *server {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	if maxBatchSize <= 0 {
+		maxBatchSize = defaultMaxBatchSize
+	}
+	// The built-in approaches have distinct names, so registering them cannot fail
+	valuers, _ := valuation.NewRegistry(approaches.Cost{}, approaches.Income{})
+	return &server{
+		workers:      workers,
+		maxBatchSize: maxBatchSize,
+		valuers:      valuers,
+		clock:        clock.System{},
+		metrics:      metrics.New(),
+		logger:       slog.Default(),
+	}
+}
+
+// approachMethods maps approach names to the method reported in results
+var approachMethods = map[string]pb.ValuationMethod{
+	valuation.ApproachCost:            pb.ValuationMethod_VALUATION_METHOD_COST,
+	valuation.ApproachSalesComparison: pb.ValuationMethod_VALUATION_METHOD_SALES_COMPARISON,
+	valuation.ApproachIncome:          pb.ValuationMethod_VALUATION_METHOD_INCOME,
+}
+
+// propertyFromProto converts and validates a property received over gRPC at the
+// current time of the request. Every RPC accepting a property must go through it so
+// that invalid input is rejected with InvalidArgument and a field violation per bad field.
+func propertyFromProto(model *valuation.PricingModel, p *pb.Property, now time.Time) (valuation.Property, error) {
+	if p == nil {
+		return valuation.Property{}, errors.ConvertToGRPCError(&errors.ValidationError{
+			Field:   "property",
+			Message: errors.ErrMissingProperty,
+		})
+	}
+
 	property := valuation.Property{
-		Address:          req.Property.Address,
-		PropertyType:     req.Property.PropertyType,
-		Bedrooms:         int(req.Property.Bedrooms),
-		Bathrooms:        int(req.Property.Bathrooms),
-		SquareFootage:    int(req.Property.SquareFootage),
-		YearBuilt:        int(req.Property.YearBuilt),
-		Condition:        req.Property.Condition,
-		MaintenanceLevel: req.Property.MaintenanceLevel,
-		RenovationStatus: req.Property.RenovationStatus,
-		Features:         req.Property.Features,
-	}
-
-	estimatedValue, confidence, explanation := valuation.CalculateValuation(property)
-
-	return &pb.ValuationResponse{
-		Result: &pb.ValuationResult{
-			Value:       estimatedValue,
-			Confidence:  confidence,
-			Explanation: explanation,
-			Issues:      []string{},
+		Address:          p.Address,
+		PropertyType:     p.PropertyType,
+		Bedrooms:         int(p.Bedrooms),
+		Bathrooms:        int(p.Bathrooms),
+		SquareFootage:    int(p.SquareFootage),
+		YearBuilt:        int(p.YearBuilt),
+		Condition:        p.Condition,
+		MaintenanceLevel: p.MaintenanceLevel,
+		RenovationStatus: p.RenovationStatus,
+		Features:         p.Features,
+		Region:           p.Region,
+		Location: valuation.Location{
+			Latitude:  p.GetLocation().GetLatitude(),
+			Longitude: p.GetLocation().GetLongitude(),
 		},
-	}, nil
+	}
+
+	if err := validation.ValidateProperty(model, property, now); err != nil {
+		return valuation.Property{}, errors.ConvertToGRPCError(err)
+	}
+	return property, nil
+}
+
+// propertyToProto converts a property into its gRPC representation
+func propertyToProto(property valuation.Property) *pb.Property {
+	p := &pb.Property{
+		Address:          property.Address,
+		PropertyType:     property.PropertyType,
+		Bedrooms:         int32(property.Bedrooms),
+		Bathrooms:        int32(property.Bathrooms),
+		SquareFootage:    int32(property.SquareFootage),
+		YearBuilt:        int32(property.YearBuilt),
+		Condition:        property.Condition,
+		MaintenanceLevel: property.MaintenanceLevel,
+		RenovationStatus: property.RenovationStatus,
+		Features:         property.Features,
+		Region:           property.Region,
+	}
+	if !property.Location.IsZero() {
+		p.Location = &pb.Location{
+			Latitude:  property.Location.Latitude,
+			Longitude: property.Location.Longitude,
+		}
+	}
+	return p
+}
+
+func (s *server) CalculateValuation(ctx context.Context, req *pb.ValuationRequest) (*pb.ValuationResponse, error) {
+	// Use a single pricing model snapshot for the whole request so that a
+	// concurrent reload cannot mix tables from two versions
+	model := s.pricingModel(ctx)
+
+	result, err := s.valuate(ctx, model, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ValuationResponse{Result: result}, nil
+}
+
+// valuate validates the property of a single request, values it with the
+// requested approach and derives the range the value lies within
+func (s *server) valuate(ctx context.Context, model *valuation.PricingModel, req *pb.ValuationRequest) (result *pb.ValuationResult, err error) {
+	ctx, span := tracing.StartSpan(ctx, "valuation.request",
+		attribute.String("valuation.request_id", req.GetRequestId()),
+		attribute.String("valuation.method", methodName(req.GetMethod())),
+		attribute.String("valuation.model_version", model.Version),
+	)
+	defer func() { tracing.EndSpan(span, err) }()
+	defer func() { s.audit(ctx, req, result, err) }()
+
+	// Read the clock once so that every stage of the request agrees on the date
+	now := s.clock.Now()
+	subject, err := subjectFromProto(model, req, now)
+	if err != nil {
+		return nil, err
+	}
+	options, err := intervalOptionsFromProto(req)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetSensitivity() && !isCostMethod(req.GetMethod()) {
+		return nil, errors.ConvertToGRPCError(&errors.ValidationError{
+			Field:   "sensitivity",
+			Message: errors.ErrSensitivityNotSupported,
+		})
+	}
+
+	switch req.GetMethod() {
+	case pb.ValuationMethod_VALUATION_METHOD_SALES_COMPARISON:
+		result, err = s.appraise(ctx, model, valuation.ApproachSalesComparison, subject)
+	case pb.ValuationMethod_VALUATION_METHOD_INCOME:
+		result, err = s.appraise(ctx, model, valuation.ApproachIncome, subject)
+	case pb.ValuationMethod_VALUATION_METHOD_RECONCILED:
+		result, err = s.reconcile(ctx, model, subject)
+	default:
+		result, err = s.appraise(ctx, model, valuation.ApproachCost, subject)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	valueRange := valuation.AnalyticalRange(result.Value, result.Confidence, options.ConfidenceLevel)
+	if options.MonteCarlo {
+		valueRange = model.SimulateRange(subject.Property, subject.AsOf, options)
+	}
+	result.ValueRange = valueRangeToProto(valueRange)
+	result.Explanation += "\n" + valueRange.Explanation()
+
+	if req.GetSensitivity() {
+		result.Sensitivity = sensitivityToProto(model.Sensitivity(subject.Property, subject.AsOf))
+	}
+	result.TenantId = tenantID(ctx)
+
+	s.observe(subject, result)
+	s.record(ctx, req, result, now)
+	return result, nil
+}
+
+// observe records the metrics of a successful valuation
+func (s *server) observe(subject valuation.Subject, result *pb.ValuationResult) {
+	categories := make([]string, 0, len(result.ValidationIssues))
+	for _, issue := range result.ValidationIssues {
+		categories = append(categories, issue.Category)
+	}
+	s.metrics.ObserveValuation(subject.Property.PropertyType, methodName(result.Method), result.Confidence, categories)
+}
+
+// methodName returns the lowercase name of a valuation method, e.g. sales_comparison
+func methodName(method pb.ValuationMethod) string {
+	return strings.ToLower(strings.TrimPrefix(method.String(), "VALUATION_METHOD_"))
+}
+
+// intervalOptionsFromProto converts and validates the value range options of a request
+func intervalOptionsFromProto(req *pb.ValuationRequest) (valuation.IntervalOptions, error) {
+	interval := req.GetInterval()
+	options := valuation.IntervalOptions{
+		ConfidenceLevel:        interval.GetConfidenceLevel(),
+		MonteCarlo:             interval.GetMonteCarlo(),
+		Simulations:            int(interval.GetSimulations()),
+		SquareFootageTolerance: interval.GetSquareFootageTolerance(),
+		ConditionUncertainty:   interval.GetConditionUncertainty(),
+		Seed:                   interval.GetSeed(),
+	}
+	if err := validation.ValidateIntervalOptions(options); err != nil {
+		return valuation.IntervalOptions{}, errors.ConvertToGRPCError(err)
+	}
+
+	// Only the cost approach values a property from inputs that can be perturbed
+	if options.MonteCarlo && !isCostMethod(req.GetMethod()) {
+		return valuation.IntervalOptions{}, errors.ConvertToGRPCError(&errors.ValidationError{
+			Field:   "interval.monte_carlo",
+			Message: errors.ErrMonteCarloNotSupported,
+		})
+	}
+	return options, nil
+}
+
+// isCostMethod reports whether a request is valued with the cost approach
+func isCostMethod(method pb.ValuationMethod) bool {
+	return method == pb.ValuationMethod_VALUATION_METHOD_UNSPECIFIED || method == pb.ValuationMethod_VALUATION_METHOD_COST
+}
+
+// sensitivityToProto converts a ranked sensitivity analysis into its gRPC representation
+func sensitivityToProto(factors []valuation.SensitivityFactor) []*pb.SensitivityFactor {
+	result := make([]*pb.SensitivityFactor, 0, len(factors))
+	for _, factor := range factors {
+		result = append(result, &pb.SensitivityFactor{
+			Input:     factor.Input,
+			LowInput:  factor.LowInput,
+			HighInput: factor.HighInput,
+			LowValue:  factor.LowValue,
+			HighValue: factor.HighValue,
+			Swing:     factor.Swing,
+		})
+	}
+	return result
+}
+
+// valueRangeToProto converts a value range into its gRPC representation
+func valueRangeToProto(r valuation.ValueRange) *pb.ValueRange {
+	valueRange := &pb.ValueRange{
+		Low:             r.Low,
+		High:            r.High,
+		ConfidenceLevel: r.ConfidenceLevel,
+		Method:          r.Method,
+	}
+	for _, percentile := range r.Percentiles {
+		valueRange.Percentiles = append(valueRange.Percentiles, &pb.Percentile{
+			Percentile: percentile.Percentile,
+			Value:      percentile.Value,
+		})
+	}
+	return valueRange
+}
+
+// subjectFromProto converts and validates the property of a request together with
+// the approach-specific inputs it carries, valuing it at the current time by default
+func subjectFromProto(model *valuation.PricingModel, req *pb.ValuationRequest, now time.Time) (valuation.Subject, error) {
+	property, err := propertyFromProto(model, req.GetProperty(), now)
+	if err != nil {
+		return valuation.Subject{}, err
+	}
+
+	asOf := now
+	if req.GetValuationDate() != nil {
+		asOf = req.GetValuationDate().AsTime()
+	}
+	if err := validation.ValidateValuationDate(model, property, asOf, now); err != nil {
+		return valuation.Subject{}, errors.ConvertToGRPCError(err)
+	}
+
+	subject := valuation.Subject{Property: property, AsOf: asOf}
+	if data := req.GetIncome(); data != nil {
+		subject.Inputs = map[string]any{valuation.ApproachIncome: incomeDataFromProto(data)}
+	}
+	return subject, nil
+}
+
+// appraise values a validated subject with a single registered approach
+func (s *server) appraise(ctx context.Context, model *valuation.PricingModel, approach string, subject valuation.Subject) (*pb.ValuationResult, error) {
+	valuer, exists := s.valuers.Get(approach)
+	if !exists {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s approach is not available", approach)
+	}
+
+	appraisal, err := valuer.Value(ctx, model, subject)
+	if err != nil {
+		return nil, approachError(err)
+	}
+
+	result := &pb.ValuationResult{
+		Value:        appraisal.Value,
+		Confidence:   appraisal.Confidence,
+		Explanation:  appraisal.Explanation,
+		ModelVersion: model.Version,
+		Method:       approachMethods[approach],
+	}
+	addDetails(result, appraisal.Details)
+	return result, nil
+}
+
+// reconcile values a validated subject with every applicable approach and
+// reconciles their values using the pricing model weights for its property type
+func (s *server) reconcile(ctx context.Context, model *valuation.PricingModel, subject valuation.Subject) (*pb.ValuationResult, error) {
+	reconciliation, err := s.valuers.Reconcile(ctx, model, subject)
+	if err != nil {
+		return nil, approachError(err)
+	}
+
+	result := &pb.ValuationResult{
+		Value:        reconciliation.Value,
+		Confidence:   reconciliation.Confidence,
+		Explanation:  reconciliation.Explanation(),
+		ModelVersion: model.Version,
+		Method:       pb.ValuationMethod_VALUATION_METHOD_RECONCILED,
+	}
+	for _, approach := range reconciliation.Approaches {
+		value := &pb.ApproachValue{
+			Approach:   approach.Approach,
+			Value:      approach.Value,
+			Confidence: approach.Confidence,
+			Weight:     approach.Weight,
+		}
+		if approach.Err != nil {
+			value.Error = approach.Err.Error()
+		} else {
+			addDetails(result, approach.Details)
+		}
+		result.Approaches = append(result.Approaches, value)
+	}
+	return result, nil
+}
+
+// addDetails sets the approach-specific fields of a result from an appraisal's details
+func addDetails(result *pb.ValuationResult, details any) {
+	switch details := details.(type) {
+	case valuation.ValuationBreakdown:
+		result.Issues, result.ValidationIssues = issuesToProto(details.ValidationIssues)
+		result.Breakdown = breakdownToProto(details)
+	case comparables.Result:
+		result.Comparables = comparablesToProto(details.Comparables)
+	case income.Result:
+		result.Income = incomeAnalysisToProto(details)
+	}
+}
+
+// approachError converts an error returned by a valuer into a gRPC status
+func approachError(err error) error {
+	if stderrors.Is(err, comparables.ErrInsufficientComparables) || stderrors.Is(err, valuation.ErrNoApproachApplied) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return errors.ConvertToGRPCError(err)
+}
+
+// breakdownToProto converts a valuation breakdown into its gRPC representation
+func breakdownToProto(b valuation.ValuationBreakdown) *pb.ValuationBreakdown {
+	adjustments := make([]*pb.Adjustment, 0, len(b.ValidationAdjustments))
+	for _, adjustment := range b.ValidationAdjustments {
+		adjustments = append(adjustments, &pb.Adjustment{
+			Category: adjustment.Category,
+			Factor:   adjustment.Factor,
+		})
+	}
+
+	features := make([]*pb.FeatureAddition, 0, len(b.FeatureAdditions))
+	for _, feature := range b.FeatureAdditions {
+		features = append(features, &pb.FeatureAddition{
+			Feature: feature.Feature,
+			Value:   feature.Value,
+		})
+	}
+
+	uncertainty := make([]*pb.UncertaintySource, 0, len(b.Uncertainty))
+	for _, source := range b.Uncertainty {
+		uncertainty = append(uncertainty, &pb.UncertaintySource{
+			Source:              source.Source,
+			RelativeUncertainty: source.RelativeUncertainty,
+		})
+	}
+
+	return &pb.ValuationBreakdown{
+		PricePerSquareFoot:    b.PricePerSquareFoot,
+		ValuationDate:         timestamppb.New(b.ValuationDate),
+		PriceIndexRegion:      b.PriceIndexRegion,
+		PriceIndexFactor:      b.PriceIndexFactor,
+		BaseValue:             b.BaseValue,
+		LocationClass:         b.LocationClass,
+		LocationMultiplier:    b.LocationMultiplier,
+		ConditionDescription:  b.ConditionDescription,
+		ConditionMultiplier:   b.ConditionMultiplier,
+		ValidationScore:       b.ValidationScore,
+		ValidationAdjustments: adjustments,
+		AdjustedMultiplier:    b.AdjustedMultiplier,
+		FeatureAdditions:      features,
+		FeatureValue:          b.FeatureValue,
+		AgeDepreciation:       b.AgeDepreciation,
+		BedroomValue:          b.BedroomValue,
+		BathroomValue:         b.BathroomValue,
+		FinalValue:            b.FinalValue,
+		Uncertainty:           uncertainty,
+		RelativeUncertainty:   b.RelativeUncertainty,
+	}
+}
+
+// issuesToProto converts condition-validation findings into both the typed
+// Issue messages and the deprecated plain-text issue list
+func issuesToProto(issues []valuation.ValidationIssue) ([]string, []*pb.Issue) {
+	descriptions := make([]string, 0, len(issues))
+	typed := make([]*pb.Issue, 0, len(issues))
+	for _, issue := range issues {
+		descriptions = append(descriptions, issue.Description)
+		typed = append(typed, &pb.Issue{
+			Description: issue.Description,
+			Severity:    issue.Severity,
+			Category:    issue.Category,
+			Field:       issue.Field,
+		})
+	}
+	return descriptions, typed
 }
 
 func main() {
-	lis, err := net.Listen("tcp", ":50051")
+	cfg, err := loadConfig(os.Args[1:], os.Getenv)
+	if stderrors.Is(err, flag.ErrHelp) {
+		return
+	}
 	if err != nil {
-		log.Fatalf("failed to listen: %v", err)
+		fmt.Fprintf(os.Stderr, "invalid configuration: %v\n", err)
+		os.Exit(2)
 	}
 
-	s := grpc.NewServer()
-	pb.RegisterValuationServiceServer(s, &server{})
+	level, _ := cfg.logLevel() // Validated by loadConfig
+	logger := logging.New(os.Stderr, level)
+	slog.SetDefault(logger)
+
+	// SIGTERM stops the server gracefully, draining in-flight requests
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
-	fmt.Println("Property Valuation gRPC Server is running on :50051")
-	if err := s.Serve(lis); err != nil {
-		log.Fatalf("failed to serve: %v", err)
+	a, err := newApp(cfg, logger)
+	if err != nil {
+		logger.Error("failed to start server", "error", err)
+		os.Exit(1)
+	}
+	if err := a.serve(ctx); err != nil {
+		logger.Error("server stopped", "error", err)
+		os.Exit(1)
 	}
-} 
\ No newline at end of file
+	logger.Info("server stopped")
+}
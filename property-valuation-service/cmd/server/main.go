@@ -1,10 +0,0 @@
-package main
-
-import (
-	"github.com/jsarcade/property-valuation-service/proto"
-	"github.com/jsarcade/property-valuation-service/pkg/valuation"
-)
-
-func main() {
-	// Use pb and valuation as needed in your code
-} 
\ No newline at end of file
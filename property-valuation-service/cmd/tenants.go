@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+
+	pb "github.com/jsarcade/property-valuation-service/proto"
+	"github.com/jsarcade/property-valuation-service/pkg/auth"
+	"github.com/jsarcade/property-valuation-service/pkg/errors"
+	"github.com/jsarcade/property-valuation-service/pkg/tenant"
+	"github.com/jsarcade/property-valuation-service/pkg/validation"
+	"github.com/jsarcade/property-valuation-service/pkg/valuation"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// errTenantPricingDisabled is returned by UpsertTenantPricing when no tenant pricing store is configured
+var errTenantPricingDisabled = status.Error(codes.FailedPrecondition, "tenant pricing is not enabled: configure tenant-pricing")
+
+// tenantID returns the tenant the caller of a request acts for, or "" for callers
+// of no tenant and when authentication is disabled
+func tenantID(ctx context.Context) string {
+	if principal, ok := auth.PrincipalFromContext(ctx); ok {
+		return principal.Tenant
+	}
+	return ""
+}
+
+// pricingModel returns the pricing model a request is valued with: the active model
+// with the overrides of the caller's tenant layered on it
+func (s *server) pricingModel(ctx context.Context) *valuation.PricingModel {
+	model := valuation.ActiveModel()
+	if id := tenantID(ctx); id != "" && s.tenants != nil {
+		return s.tenants.Model(model, id)
+	}
+	return model
+}
+
+func (s *server) UpsertTenantPricing(ctx context.Context, req *pb.UpsertTenantPricingRequest) (*pb.TenantPricing, error) {
+	if s.tenants == nil {
+		return nil, errTenantPricingDisabled
+	}
+	overrides := tenant.Overrides{
+		BasePricePerSquareFoot: req.GetOverrides().GetBasePricePerSquareFoot(),
+		FeatureValue:           req.GetOverrides().GetFeatureValue(),
+		LocationMultiplier:     req.GetOverrides().GetLocationMultiplier(),
+	}
+	if err := validation.ValidateTenantPricing(req.GetTenantId(), overrides); err != nil {
+		return nil, errors.ConvertToGRPCError(err)
+	}
+
+	pricing, err := s.tenants.Put(req.GetTenantId(), overrides)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to store tenant pricing", "tenant", req.GetTenantId(), "error", err)
+		return nil, status.Error(codes.Internal, "failed to store tenant pricing")
+	}
+	version := pricing.Version(valuation.ActiveModel())
+	s.logger.InfoContext(ctx, "tenant pricing updated", "tenant", pricing.Tenant, "revision", pricing.Revision,
+		"model_version", version, "principal", principalName(ctx))
+
+	return &pb.TenantPricing{
+		TenantId: pricing.Tenant,
+		Overrides: &pb.PricingOverrides{
+			BasePricePerSquareFoot: pricing.Overrides.BasePricePerSquareFoot,
+			FeatureValue:           pricing.Overrides.FeatureValue,
+			LocationMultiplier:     pricing.Overrides.LocationMultiplier,
+		},
+		Revision:     pricing.Revision,
+		UpdateTime:   timestamppb.New(pricing.UpdatedAt),
+		ModelVersion: version,
+	}, nil
+}
@@ -0,0 +1,235 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jsarcade/property-valuation-service/pkg/auth"
+	"github.com/jsarcade/property-valuation-service/pkg/history"
+	"github.com/jsarcade/property-valuation-service/pkg/tenant"
+	"github.com/jsarcade/property-valuation-service/pkg/testutil"
+	"github.com/jsarcade/property-valuation-service/pkg/valuation"
+	pb "github.com/jsarcade/property-valuation-service/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestTenantPricing(t *testing.T) {
+	dir := t.TempDir()
+	keys := filepath.Join(dir, "api_keys.yaml")
+	content := "keys:\n" +
+		"  - {name: acme-crm, hash: " + auth.HashAPIKey("acme-key") + ", role: appraiser, tenant: acme}\n" +
+		"  - {name: globex-crm, hash: " + auth.HashAPIKey("globex-key") + ", role: appraiser, tenant: globex}\n" +
+		"  - {name: crm, hash: " + auth.HashAPIKey("crm-key") + ", role: appraiser}\n" +
+		"  - {name: ops, hash: " + auth.HashAPIKey("admin-key") + ", role: admin}\n"
+	if err := os.WriteFile(keys, []byte(content), 0o600); err != nil {
+		t.Fatalf("Failed to write API keys: %v", err)
+	}
+
+	cfg := defaultConfig()
+	cfg.APIKeys = keys
+	a := &app{cfg: cfg, srv: newServer(2, 10), health: health.NewServer(), logger: discardLogger}
+	a.srv.logger = discardLogger
+	tenants, err := tenant.Open(filepath.Join(dir, "tenants.db"), a.srv.clock)
+	if err != nil {
+		t.Fatalf("Failed to open tenant pricing: %v", err)
+	}
+	defer tenants.Close()
+	a.srv.tenants = tenants
+	store, err := history.Open(filepath.Join(dir, "history.db"))
+	if err != nil {
+		t.Fatalf("Failed to open history: %v", err)
+	}
+	defer store.Close()
+	a.srv.history = store
+	if err := a.setupAuth(); err != nil {
+		t.Fatalf("setupAuth failed: %v", err)
+	}
+
+	grpcServer := a.newGRPCServer()
+	lis, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	go grpcServer.Serve(lis)
+	defer grpcServer.Stop()
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("Failed to connect to server: %v", err)
+	}
+	defer conn.Close()
+	client := pb.NewValuationServiceClient(conn)
+	property := toProto(testutil.CreateTestProperty())
+	withKey := func(key string) context.Context {
+		return metadata.AppendToOutgoingContext(context.Background(), auth.APIKeyKey, key)
+	}
+	calculate := func(key string) *pb.ValuationResult {
+		t.Helper()
+		resp, err := client.CalculateValuation(withKey(key), &pb.ValuationRequest{Property: property})
+		if err != nil {
+			t.Fatalf("CalculateValuation with %s failed: %v", key, err)
+		}
+		return resp.GetResult()
+	}
+
+	base := calculate("acme-key")
+	if base.GetTenantId() != "acme" || base.GetModelVersion() != valuation.ActiveModel().Version {
+		t.Errorf("Result before any override = tenant %q, model %s, want tenant acme, base model", base.GetTenantId(), base.GetModelVersion())
+	}
+
+	pricing, err := client.UpsertTenantPricing(withKey("admin-key"), &pb.UpsertTenantPricingRequest{
+		TenantId: "acme",
+		Overrides: &pb.PricingOverrides{
+			BasePricePerSquareFoot: map[string]float64{"house": 2 * valuation.ActiveModel().BasePricePerSquareFoot["house"]},
+			FeatureValue:           map[string]float64{"sauna": 15000},
+		},
+	})
+	if err != nil {
+		t.Fatalf("UpsertTenantPricing failed: %v", err)
+	}
+	wantVersion := valuation.ActiveModel().Version + "+acme.1"
+	if pricing.GetRevision() != 1 || pricing.GetModelVersion() != wantVersion {
+		t.Errorf("Pricing = revision %d, model %s, want revision 1, model %s", pricing.GetRevision(), pricing.GetModelVersion(), wantVersion)
+	}
+
+	acme := calculate("acme-key")
+	if acme.GetValue() <= base.GetValue() || acme.GetModelVersion() != wantVersion || acme.GetTenantId() != "acme" {
+		t.Errorf("acme result = %.0f with model %s for tenant %q, want above %.0f with model %s for acme",
+			acme.GetValue(), acme.GetModelVersion(), acme.GetTenantId(), base.GetValue(), wantVersion)
+	}
+	for key, wantTenant := range map[string]string{"globex-key": "globex", "crm-key": ""} {
+		result := calculate(key)
+		if result.GetValue() != base.GetValue() || result.GetTenantId() != wantTenant {
+			t.Errorf("Result with %s = %.0f for tenant %q, want the base value %.0f for tenant %q",
+				key, result.GetValue(), result.GetTenantId(), base.GetValue(), wantTenant)
+		}
+	}
+
+	// Reference data and history reflect the tenant of the caller
+	features, err := client.ListFeatures(withKey("acme-key"), &pb.ListFeaturesRequest{})
+	if err != nil {
+		t.Fatalf("ListFeatures failed: %v", err)
+	}
+	if features.GetModelVersion() != wantVersion {
+		t.Errorf("ListFeatures model = %s, want %s", features.GetModelVersion(), wantVersion)
+	}
+	record, err := client.GetValuation(withKey("acme-key"), &pb.GetValuationRequest{Id: acme.GetValuationId()})
+	if err != nil {
+		t.Fatalf("GetValuation failed: %v", err)
+	}
+	if record.GetTenantId() != "acme" || record.GetModelVersion() != wantVersion {
+		t.Errorf("Record = tenant %q, model %s, want tenant acme, model %s", record.GetTenantId(), record.GetModelVersion(), wantVersion)
+	}
+
+	t.Run("History Isolation", func(t *testing.T) {
+		globex := calculate("globex-key")
+		for _, key := range []string{"globex-key", "crm-key"} {
+			_, err := client.GetValuation(withKey(key), &pb.GetValuationRequest{Id: acme.GetValuationId()})
+			if status.Code(err) != codes.NotFound {
+				t.Errorf("GetValuation of acme's valuation with %s = %v, want NotFound", key, err)
+			}
+		}
+		if _, err := client.GetValuation(withKey("admin-key"), &pb.GetValuationRequest{Id: acme.GetValuationId()}); err != nil {
+			t.Errorf("GetValuation of acme's valuation as admin failed: %v", err)
+		}
+
+		// Both tenants valued the same property, but each only reads its own valuations
+		list, err := client.ListValuations(withKey("acme-key"), &pb.ListValuationsRequest{Address: property.GetAddress()})
+		if err != nil {
+			t.Fatalf("ListValuations failed: %v", err)
+		}
+		if len(list.GetValuations()) != 2 {
+			t.Errorf("ListValuations of acme = %d valuations, want 2", len(list.GetValuations()))
+		}
+		for _, record := range list.GetValuations() {
+			if record.GetTenantId() != "acme" {
+				t.Errorf("ListValuations of acme returned a valuation of tenant %q", record.GetTenantId())
+			}
+		}
+		latest, err := client.GetValuationAsOf(withKey("acme-key"), &pb.GetValuationAsOfRequest{Address: property.GetAddress()})
+		if err != nil {
+			t.Fatalf("GetValuationAsOf failed: %v", err)
+		}
+		if latest.GetId() != acme.GetValuationId() {
+			t.Errorf("GetValuationAsOf of acme = %s, want %s rather than the later valuation %s of globex",
+				latest.GetId(), acme.GetValuationId(), globex.GetValuationId())
+		}
+	})
+
+	tests := []struct {
+		name     string
+		key      string
+		req      *pb.UpsertTenantPricingRequest
+		wantCode codes.Code
+	}{
+		{"Appraiser", "acme-key", &pb.UpsertTenantPricingRequest{TenantId: "acme"}, codes.PermissionDenied},
+		{"Invalid tenant ID", "admin-key", &pb.UpsertTenantPricingRequest{TenantId: "Acme Realty"}, codes.InvalidArgument},
+		{"Non-positive price", "admin-key", &pb.UpsertTenantPricingRequest{TenantId: "acme", Overrides: &pb.PricingOverrides{
+			BasePricePerSquareFoot: map[string]float64{"house": 0},
+		}}, codes.InvalidArgument},
+		{"Negative feature value", "admin-key", &pb.UpsertTenantPricingRequest{TenantId: "acme", Overrides: &pb.PricingOverrides{
+			FeatureValue: map[string]float64{"garage": -1},
+		}}, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.UpsertTenantPricing(withKey(tt.key), tt.req)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Errorf("Code = %v, want %v", code, tt.wantCode)
+			}
+		})
+	}
+
+	t.Run("REST Gateway", func(t *testing.T) {
+		gateway, err := newGateway(context.Background(), conn)
+		if err != nil {
+			t.Fatalf("Failed to create gateway: %v", err)
+		}
+		httpServer := httptest.NewServer(gateway)
+		defer httpServer.Close()
+
+		body := []byte(`{"locationMultiplier": {"urban": 1.5}}`)
+		req, _ := http.NewRequest(http.MethodPut, httpServer.URL+"/v1/tenants/globex/pricing", bytes.NewReader(body))
+		req.Header.Set("X-Api-Key", "admin-key")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("PUT failed: %v", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("Status = %d, want %d", resp.StatusCode, http.StatusOK)
+		}
+		data, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("Failed to read response: %v", err)
+		}
+		var pricing pb.TenantPricing
+		if err := protojson.Unmarshal(data, &pricing); err != nil {
+			t.Fatalf("Failed to decode response: %v", err)
+		}
+		if pricing.GetTenantId() != "globex" || pricing.GetOverrides().GetLocationMultiplier()["urban"] != 1.5 {
+			t.Errorf("Pricing = %v, want the urban multiplier of globex", &pricing)
+		}
+	})
+}
+
+func TestUpsertTenantPricingDisabled(t *testing.T) {
+	srv := newServer(1, 1)
+	_, err := srv.UpsertTenantPricing(context.Background(), &pb.UpsertTenantPricingRequest{TenantId: "acme"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("UpsertTenantPricing error = %v, want FailedPrecondition", err)
+	}
+}
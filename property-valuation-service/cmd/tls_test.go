@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jsarcade/property-valuation-service/pkg/testutil"
+	pb "github.com/jsarcade/property-valuation-service/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+)
+
+func TestMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := testutil.NewCA(t, "Test CA")
+	cfg := defaultConfig()
+	cfg.GRPCAddr = "localhost:0"
+	cfg.HTTPAddr = "localhost:0"
+	cfg.MetricsAddr = ""
+	cfg.DrainTimeout = time.Second
+	cfg.TLSCert, cfg.TLSKey = ca.WriteIssued(t, dir, "server", true)
+	cfg.TLSClientCA = ca.WriteCert(t, dir)
+
+	a, err := newApp(cfg, discardLogger)
+	if err != nil {
+		t.Fatalf("newApp failed: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	served := make(chan error, 1)
+	go func() { served <- a.serve(ctx) }()
+	defer func() {
+		cancel()
+		if err := <-served; err != nil {
+			t.Errorf("serve failed: %v", err)
+		}
+	}()
+
+	clientCert, clientKey := ca.Issue(t, "client", false)
+	trusted, err := tls.X509KeyPair(clientCert, clientKey)
+	if err != nil {
+		t.Fatalf("Failed to load client certificate: %v", err)
+	}
+	rogueCert, rogueKey := testutil.NewCA(t, "Rogue CA").Issue(t, "client", false)
+	rogue, err := tls.X509KeyPair(rogueCert, rogueKey)
+	if err != nil {
+		t.Fatalf("Failed to load client certificate: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		certs  []tls.Certificate
+		wantOK bool
+	}{
+		{"Trusted client certificate", []tls.Certificate{trusted}, true},
+		{"No client certificate", nil, false},
+		{"Client certificate of another CA", []tls.Certificate{rogue}, false},
+	}
+	for _, tt := range tests {
+		clientTLS := &tls.Config{RootCAs: ca.Pool(), ServerName: "localhost", Certificates: tt.certs}
+
+		t.Run("gRPC/"+tt.name, func(t *testing.T) {
+			conn, err := grpc.NewClient(a.grpcListener.Addr().String(), grpc.WithTransportCredentials(credentials.NewTLS(clientTLS)))
+			if err != nil {
+				t.Fatalf("Failed to connect to server: %v", err)
+			}
+			defer conn.Close()
+
+			callCtx, callCancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer callCancel()
+			_, err = pb.NewValuationServiceClient(conn).ListPropertyTypes(callCtx, &pb.ListPropertyTypesRequest{})
+			if tt.wantOK && err != nil {
+				t.Errorf("ListPropertyTypes failed: %v", err)
+			}
+			if !tt.wantOK && status.Code(err) != codes.Unavailable {
+				t.Errorf("ListPropertyTypes error = %v, want Unavailable", err)
+			}
+		})
+
+		t.Run("REST/"+tt.name, func(t *testing.T) {
+			client := &http.Client{Transport: &http.Transport{TLSClientConfig: clientTLS}, Timeout: 5 * time.Second}
+			resp, err := client.Get("https://" + a.httpListener.Addr().String() + "/v1/propertyTypes")
+			if err == nil {
+				resp.Body.Close()
+			}
+			if tt.wantOK && (err != nil || resp.StatusCode != http.StatusOK) {
+				t.Errorf("GET failed: %v", err)
+			}
+			if !tt.wantOK && err == nil {
+				t.Errorf("GET succeeded with status %d, want a TLS error", resp.StatusCode)
+			}
+		})
+	}
+}
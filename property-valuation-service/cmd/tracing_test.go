@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/jsarcade/property-valuation-service/pkg/testutil"
+	pb "github.com/jsarcade/property-valuation-service/proto"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// spanNames returns the names of the recorded spans of a trace
+func spanNames(spans []sdktrace.ReadOnlySpan, traceID trace.TraceID) []string {
+	var names []string
+	for _, span := range spans {
+		if span.SpanContext().TraceID() == traceID {
+			names = append(names, span.Name())
+		}
+	}
+	return names
+}
+
+func TestTracePropagation(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	a := &app{
+		cfg:            defaultConfig(),
+		srv:            newServer(2, 10),
+		health:         health.NewServer(),
+		logger:         discardLogger,
+		tracerProvider: sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)),
+	}
+	grpcServer := a.newGRPCServer()
+	lis, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	go grpcServer.Serve(lis)
+	defer grpcServer.Stop()
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("Failed to connect to server: %v", err)
+	}
+	defer conn.Close()
+
+	request := &pb.ValuationRequest{Property: toProto(testutil.CreateTestProperty())}
+	wantSpans := []string{
+		"valuation.calculate",
+		"valuation.request",
+		"valuation.ValuationService/CalculateValuation",
+	}
+
+	// The trace context the API gateway sends with each request
+	const traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	const callerSpanID = "00f067aa0ba902b7"
+
+	t.Run("gRPC", func(t *testing.T) {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), "traceparent", "00-"+traceID+"-"+callerSpanID+"-01")
+		if _, err := pb.NewValuationServiceClient(conn).CalculateValuation(ctx, request); err != nil {
+			t.Fatalf("CalculateValuation failed: %v", err)
+		}
+
+		id, _ := trace.TraceIDFromHex(traceID)
+		names := spanNames(recorder.Ended(), id)
+		for _, want := range wantSpans {
+			if !slices.Contains(names, want) {
+				t.Errorf("Trace %s spans = %v, want %s", traceID, names, want)
+			}
+		}
+		for _, span := range recorder.Ended() {
+			if span.SpanKind() == trace.SpanKindServer && span.Parent().SpanID().String() != callerSpanID {
+				t.Errorf("Server span parent = %s, want the caller's span %s", span.Parent().SpanID(), callerSpanID)
+			}
+		}
+	})
+
+	t.Run("REST Gateway", func(t *testing.T) {
+		gateway, err := newGateway(context.Background(), conn)
+		if err != nil {
+			t.Fatalf("Failed to create gateway: %v", err)
+		}
+		httpServer := httptest.NewServer(gateway)
+		defer httpServer.Close()
+
+		body, err := protojson.Marshal(request)
+		if err != nil {
+			t.Fatalf("Failed to marshal request: %v", err)
+		}
+		const restTraceID = "5bf92f3577b34da6a3ce929d0e0e4736"
+		req, _ := http.NewRequest(http.MethodPost, httpServer.URL+"/v1/valuations:calculate", strings.NewReader(string(body)))
+		req.Header.Set("Traceparent", "00-"+restTraceID+"-"+callerSpanID+"-01")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("POST failed: %v", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("Status = %d, want %d", resp.StatusCode, http.StatusOK)
+		}
+
+		id, _ := trace.TraceIDFromHex(restTraceID)
+		if names := spanNames(recorder.Ended(), id); len(names) < len(wantSpans) {
+			t.Errorf("Trace %s spans = %v, want the REST request to continue the caller's trace", restTraceID, names)
+		}
+	})
+}
@@ -1,12 +1,41 @@
 const grpc = require('@grpc/grpc-js');
 const protoLoader = require('@grpc/proto-loader');
+const fs = require('fs');
 const path = require('path');
 
+// W3C trace context headers forwarded to the valuation service
+const TRACE_HEADERS = ['traceparent', 'tracestate', 'baggage'];
+
+// Copy the trace context headers of an incoming HTTP request into gRPC metadata
+// so that the valuation service continues the caller's trace
+function traceMetadata(headers = {}) {
+  const metadata = new grpc.Metadata();
+  TRACE_HEADERS.forEach((name) => {
+    if (headers[name]) {
+      metadata.set(name, headers[name]);
+    }
+  });
+  return metadata;
+}
+
+// Build the channel credentials of the client: TLS when tls options are given, with
+// a client certificate for mutual TLS when cert and key are set. ca, cert and key are
+// paths to PEM files; the system roots verify the server when ca is not set.
+function channelCredentials(tls) {
+  if (!tls) {
+    return grpc.credentials.createInsecure();
+  }
+  const read = (file) => (file ? fs.readFileSync(file) : null);
+  return grpc.credentials.createSsl(read(tls.ca), read(tls.key), read(tls.cert));
+}
+
 class ValuationClient {
   constructor(address = 'localhost:50051', options = {}) {
     this.address = address;
     this.options = {
       timeout: options.timeout || 5000, // Default 5 second timeout
+      apiKey: options.apiKey, // Sent as x-api-key when the service requires authentication
+      tls: options.tls, // { ca, cert, key } PEM file paths; the connection is insecure when unset
     };
 
     // Load the proto file
@@ -22,10 +51,20 @@ class ValuationClient {
     const protoDescriptor = grpc.loadPackageDefinition(packageDefinition);
     this.client = new protoDescriptor.valuation.ValuationService(
       this.address,
-      grpc.credentials.createInsecure()
+      channelCredentials(this.options.tls)
     );
   }
 
+  // Add the API key, if any, to the metadata of a request
+  withCredentials(metadata) {
+    if (!this.options.apiKey) {
+      return metadata;
+    }
+    const withKey = metadata ? metadata.clone() : new grpc.Metadata();
+    withKey.set('x-api-key', this.options.apiKey);
+    return withKey;
+  }
+
   // Validate property before sending to server
   validateProperty(property) {
     const errors = [];
@@ -69,15 +108,17 @@ class ValuationClient {
     return errors;
   }
 
-  // Calculate valuation with error handling
-  async calculateValuation(property) {
+  // Calculate valuation with error handling; metadata, e.g. from traceMetadata, is
+  // sent along with the request when given
+  async calculateValuation(property, metadata) {
     return new Promise((resolve, reject) => {
       const deadline = new Date();
       deadline.setMilliseconds(deadline.getMilliseconds() + this.options.timeout);
 
+      metadata = this.withCredentials(metadata);
+      const args = metadata ? [{ property }, metadata, { deadline }] : [{ property }, { deadline }];
       this.client.calculateValuation(
-        { property },
-        { deadline },
+        ...args,
         (error, response) => {
           if (error) {
             reject(error);
@@ -88,13 +129,39 @@ class ValuationClient {
       );
     });
   }
+
+  // Value several properties in one call; each item carries either a result or an error
+  async batchCalculateValuation(properties, metadata) {
+    return new Promise((resolve, reject) => {
+      const deadline = new Date();
+      deadline.setMilliseconds(deadline.getMilliseconds() + this.options.timeout);
+
+      const requests = properties.map((property, index) => ({
+        property,
+        request_id: property.request_id || String(index),
+      }));
+
+      metadata = this.withCredentials(metadata);
+      const args = metadata ? [{ requests }, metadata, { deadline }] : [{ requests }, { deadline }];
+      this.client.batchCalculateValuation(
+        ...args,
+        (error, response) => {
+          if (error) {
+            reject(error);
+            return;
+          }
+          resolve(response.items);
+        }
+      );
+    });
+  }
 }
 
-module.exports = { ValuationClient };
+module.exports = { ValuationClient, traceMetadata };
 
 // Example usage
 async function main() {
-  const client = new ValuationClient();
+  const client = new ValuationClient('localhost:50051', { apiKey: process.env.VALUATION_API_KEY });
 
   // Example property
   const property = {
@@ -123,8 +190,11 @@ async function main() {
     console.log('Value:', result.value);
     console.log('Confidence:', result.confidence);
     console.log('Explanation:', result.explanation);
-    if (result.issues && result.issues.length > 0) {
-      console.log('Issues:', result.issues);
+    if (result.validation_issues && result.validation_issues.length > 0) {
+      console.log('Issues:');
+      result.validation_issues.forEach((issue) => {
+        console.log(`  [${issue.category}] ${issue.field}: ${issue.description} (severity ${issue.severity})`);
+      });
     }
   } catch (error) {
     console.error('Error:', error.message);
@@ -0,0 +1,116 @@
+package approaches
+
+import (
+	"context"
+
+	"github.com/jsarcade/property-valuation-service/pkg/comparables"
+	"github.com/jsarcade/property-valuation-service/pkg/errors"
+	"github.com/jsarcade/property-valuation-service/pkg/income"
+	"github.com/jsarcade/property-valuation-service/pkg/validation"
+	"github.com/jsarcade/property-valuation-service/pkg/valuation"
+)
+
+// Cost values properties from the pricing model tables; details are a valuation.ValuationBreakdown
+type Cost struct{}
+
+// Approach returns the name of the cost approach
+func (Cost) Approach() string {
+	return valuation.ApproachCost
+}
+
+// Applies reports that the cost approach can value every validated property
+func (Cost) Applies(valuation.Subject) bool {
+	return true
+}
+
+// Value values the subject with the cost approach as of the subject's date
+func (Cost) Value(ctx context.Context, model *valuation.PricingModel, subject valuation.Subject) (valuation.Appraisal, error) {
+	value, confidence, breakdown := model.CalculateValuationContext(ctx, subject.Property, subject.AsOf)
+	return valuation.Appraisal{
+		Approach:    valuation.ApproachCost,
+		Value:       value,
+		Confidence:  confidence,
+		Explanation: breakdown.Explanation(),
+		Details:     breakdown,
+	}, nil
+}
+
+// SalesComparison values properties from recent comparable sales; details are a comparables.Result
+type SalesComparison struct {
+	Engine *comparables.Engine
+}
+
+// Approach returns the name of the sales comparison approach
+func (SalesComparison) Approach() string {
+	return valuation.ApproachSalesComparison
+}
+
+// Applies reports whether the subject has the location needed to find comparable sales
+func (SalesComparison) Applies(subject valuation.Subject) bool {
+	return !subject.Property.Location.IsZero()
+}
+
+// Value values the subject with the sales comparison approach as of the subject's date
+func (s SalesComparison) Value(_ context.Context, model *valuation.PricingModel, subject valuation.Subject) (valuation.Appraisal, error) {
+	if subject.Property.Location.IsZero() {
+		return valuation.Appraisal{}, &errors.ValidationError{
+			Field:   "location",
+			Message: errors.ErrLocationRequired,
+		}
+	}
+
+	result, err := s.Engine.Value(model, subject.Property, subject.AsOf)
+	if err != nil {
+		return valuation.Appraisal{}, err
+	}
+	return valuation.Appraisal{
+		Approach:    valuation.ApproachSalesComparison,
+		Value:       result.Value,
+		Confidence:  result.Confidence,
+		Explanation: result.Explanation(),
+		Details:     result,
+	}, nil
+}
+
+// Income values commercial properties from their net operating income; its input is
+// the income.Data stored under the approach name and details are an income.Result
+type Income struct{}
+
+// Approach returns the name of the income approach
+func (Income) Approach() string {
+	return valuation.ApproachIncome
+}
+
+// Applies reports whether the subject is an income-producing property with income data
+func (Income) Applies(subject valuation.Subject) bool {
+	_, hasData := subject.Inputs[valuation.ApproachIncome].(income.Data)
+	return hasData && income.IsIncomeProperty(subject.Property.PropertyType)
+}
+
+// Value values the subject with the income approach
+func (Income) Value(_ context.Context, model *valuation.PricingModel, subject valuation.Subject) (valuation.Appraisal, error) {
+	var violations errors.ValidationErrors
+	if !income.IsIncomeProperty(subject.Property.PropertyType) {
+		violations = append(violations, &errors.ValidationError{Field: "method", Message: errors.ErrIncomeNotApplicable})
+	}
+	data, hasData := subject.Inputs[valuation.ApproachIncome].(income.Data)
+	if !hasData {
+		violations = append(violations, &errors.ValidationError{Field: "income", Message: errors.ErrIncomeDataRequired})
+	}
+	if len(violations) > 0 {
+		return valuation.Appraisal{}, violations
+	}
+
+	if err := validation.ValidateIncome(data); err != nil {
+		return valuation.Appraisal{}, err
+	}
+
+	result := income.Value(data)
+	return valuation.Appraisal{
+		Approach:    valuation.ApproachIncome,
+		Value:       result.Value,
+		Confidence:  result.Confidence,
+		Explanation: result.Explanation(),
+		Details:     result,
+	}, nil
+}
@@ -0,0 +1,91 @@
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// Redacted replaces personal data in entries of a log opened with redaction
+const Redacted = "[redacted]"
+
+// Location represents the coordinates of a valued property
+type Location struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// Entry represents a valuation request and its outcome
+type Entry struct {
+	Time         time.Time `json:"time"`
+	RequestID    string    `json:"requestId"`
+	Principal    string    `json:"principal,omitempty"` // Authenticated caller, e.g. api_key:crm; empty when authentication is disabled
+	Tenant       string    `json:"tenant,omitempty"`    // Tenant the caller acts for, whose pricing overrides apply
+	Caller       string    `json:"caller"`              // Network address of the client
+	UserAgent    string    `json:"userAgent,omitempty"` // Client library or gateway the request came through
+	RPC          string    `json:"rpc"`
+	ValuationID  string    `json:"valuationId,omitempty"` // Set when the valuation is recorded in the history
+	Address      string    `json:"address"`
+	Location     *Location `json:"location,omitempty"`
+	PropertyType string    `json:"propertyType"`
+	Method       string    `json:"method,omitempty"`
+	Value        float64   `json:"value"`
+	Confidence   float64   `json:"confidence"`
+	ModelVersion string    `json:"modelVersion,omitempty"`
+	Error        string    `json:"error,omitempty"` // Set when the valuation failed
+}
+
+// Log appends entries as JSON lines to a file
+type Log struct {
+	mu     sync.Mutex
+	file   *os.File
+	redact bool
+}
+
+// Open opens the audit log at path for appending, creating it if needed. When redact
+// is set, the address and location of every entry are replaced before it is written.
+func Open(path string, redact bool) (*Log, error) {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("opening audit log: %w", err)
+	}
+	return &Log{file: file, redact: redact}, nil
+}
+
+// Record appends an entry to the log
+func (l *Log) Record(entry Entry) error {
+	if l.redact {
+		entry = Redact(entry)
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("encoding audit entry: %w", err)
+	}
+	data = append(data, '\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	// A single write per entry keeps lines whole when the file is shared
+	if _, err := l.file.Write(data); err != nil {
+		return fmt.Errorf("writing audit entry: %w", err)
+	}
+	return nil
+}
+
+// Close closes the log file
+func (l *Log) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.file.Close()
+}
+
+// Redact returns a copy of the entry without the personal data identifying the property
+func Redact(entry Entry) Entry {
+	if entry.Address != "" {
+		entry.Address = Redacted
+	}
+	entry.Location = nil
+	return entry
+}
@@ -0,0 +1,90 @@
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// readEntries reads every entry of an audit log file
+func readEntries(t *testing.T, path string) []Entry {
+	t.Helper()
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("Failed to open audit log: %v", err)
+	}
+	defer file.Close()
+
+	var entries []Entry
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var entry Entry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatalf("Invalid audit line %q: %v", scanner.Text(), err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func TestRecord(t *testing.T) {
+	entry := Entry{
+		Time:         time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC),
+		RequestID:    "req-1",
+		Caller:       "10.0.0.7:51234",
+		RPC:          "/valuation.ValuationService/CalculateValuation",
+		Address:      "12 Elm St",
+		Location:     &Location{Latitude: 25.76, Longitude: -80.19},
+		PropertyType: "house",
+		Value:        450000,
+		Confidence:   0.85,
+	}
+
+	tests := []struct {
+		name         string
+		redact       bool
+		wantAddress  string
+		wantLocation bool
+	}{
+		{"Plain", false, "12 Elm St", true},
+		{"Redacted", true, Redacted, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "audit.log")
+
+			// Entries are appended across reopens
+			for i := 0; i < 2; i++ {
+				log, err := Open(path, tt.redact)
+				if err != nil {
+					t.Fatalf("Open failed: %v", err)
+				}
+				if err := log.Record(entry); err != nil {
+					t.Fatalf("Record failed: %v", err)
+				}
+				if err := log.Close(); err != nil {
+					t.Fatalf("Close failed: %v", err)
+				}
+			}
+
+			entries := readEntries(t, path)
+			if len(entries) != 2 {
+				t.Fatalf("Read %d entries, want 2", len(entries))
+			}
+			for _, got := range entries {
+				if got.Address != tt.wantAddress {
+					t.Errorf("Address = %q, want %q", got.Address, tt.wantAddress)
+				}
+				if (got.Location != nil) != tt.wantLocation {
+					t.Errorf("Location = %v, want present: %v", got.Location, tt.wantLocation)
+				}
+				if got.RequestID != entry.RequestID || got.Value != entry.Value || !got.Time.Equal(entry.Time) {
+					t.Errorf("Entry = %+v, want %+v", got, entry)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package auth
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/jsarcade/property-valuation-service/pkg/tenant"
+	"gopkg.in/yaml.v3"
+)
+
+// apiKeyHashPrefix prefixes the hex-encoded SHA-256 hash of an API key
+const apiKeyHashPrefix = "sha256:"
+
+// apiKeyEntry represents a client of an API keys file
+type apiKeyEntry struct {
+	Name   string `yaml:"name"` // Identifies the client in logs and audit entries
+	Hash   string `yaml:"hash"` // HashAPIKey of the client's key; keys are never stored in clear
+	Role   Role   `yaml:"role"`
+	Tenant string `yaml:"tenant"` // Brokerage the client acts for; optional
+}
+
+// HashAPIKey returns the hash of an API key as stored in API keys files
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return apiKeyHashPrefix + hex.EncodeToString(sum[:])
+}
+
+// loadAPIKeys loads the clients of a YAML API keys file, keyed by the hash of their key
+func loadAPIKeys(path string) (map[string]Principal, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading API keys: %w", err)
+	}
+
+	var file struct {
+		Keys []apiKeyEntry `yaml:"keys"`
+	}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&file); err != nil {
+		return nil, fmt.Errorf("parsing API keys %s: %w", path, err)
+	}
+
+	keys := make(map[string]Principal, len(file.Keys))
+	names := make(map[string]bool, len(file.Keys))
+	for i, entry := range file.Keys {
+		hash := strings.ToLower(entry.Hash)
+		digest, found := strings.CutPrefix(hash, apiKeyHashPrefix)
+		switch {
+		case entry.Name == "":
+			return nil, fmt.Errorf("API key %d has no name", i)
+		case names[entry.Name]:
+			return nil, fmt.Errorf("duplicate API key name %q", entry.Name)
+		case !found || len(digest) != 2*sha256.Size || strings.Trim(digest, "0123456789abcdef") != "":
+			return nil, fmt.Errorf("API key %q: hash must be %s followed by 64 hex digits", entry.Name, apiKeyHashPrefix)
+		case !entry.Role.Valid():
+			return nil, fmt.Errorf("API key %q: unknown role %q", entry.Name, entry.Role)
+		case entry.Tenant != "" && !tenant.ValidID(entry.Tenant):
+			return nil, fmt.Errorf("API key %q: invalid tenant %q", entry.Name, entry.Tenant)
+		}
+		if _, exists := keys[hash]; exists {
+			return nil, fmt.Errorf("API key %q has the same key as another client", entry.Name)
+		}
+		names[entry.Name] = true
+		keys[hash] = Principal{Subject: entry.Name, Role: entry.Role, Method: MethodAPIKey, Tenant: entry.Tenant}
+	}
+	return keys, nil
+}
@@ -0,0 +1,327 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/jsarcade/property-valuation-service/pkg/clock"
+	"github.com/jsarcade/property-valuation-service/pkg/tenant"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Role represents the access level of a caller; each role includes the access of the lower ones
+type Role string
+
+// Roles in increasing order of access
+const (
+	RoleViewer    Role = "viewer"    // Reads reference data and stored valuations
+	RoleAppraiser Role = "appraiser" // Also values properties
+	RoleAdmin     Role = "admin"     // Also administers the service, e.g. reloads the pricing model
+)
+
+var roleRanks = map[Role]int{RoleViewer: 1, RoleAppraiser: 2, RoleAdmin: 3}
+
+// Valid reports whether the role is known
+func (r Role) Valid() bool {
+	return roleRanks[r] > 0
+}
+
+// Allows reports whether the role grants the access of the required role
+func (r Role) Allows(required Role) bool {
+	return r.Valid() && roleRanks[r] >= roleRanks[required]
+}
+
+// Methods callers authenticate with
+const (
+	MethodAPIKey = "api_key"
+	MethodJWT    = "jwt"
+)
+
+// Principal represents an authenticated caller
+type Principal struct {
+	Subject string // API key name or JWT subject
+	Role    Role
+	Method  string // MethodAPIKey or MethodJWT
+	Tenant  string // Brokerage the caller acts for; empty for callers of no tenant
+}
+
+// String identifies the principal in logs and audit entries, e.g. jwt:alice
+func (p Principal) String() string {
+	return p.Method + ":" + p.Subject
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns a copy of ctx carrying the authenticated caller
+func WithPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext returns the authenticated caller of the request ctx belongs to
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(Principal)
+	return principal, ok
+}
+
+// APIKeyKey is the metadata key, and HTTP header, carrying an API key
+const APIKeyKey = "x-api-key"
+
+// DefaultRoleClaim is the JWT claim holding the caller's role, or list of roles
+const DefaultRoleClaim = "role"
+
+// DefaultTenantClaim is the JWT claim holding the tenant the caller acts for
+const DefaultTenantClaim = "tenant"
+
+// jwtLeeway tolerates clock skew between the token issuer and the service
+const jwtLeeway = 30 * time.Second
+
+// Errors returned when a caller cannot be authenticated
+var (
+	ErrNoCredentials  = errors.New("missing credentials: send an x-api-key header or an authorization bearer token")
+	ErrInvalidAPIKey  = errors.New("invalid API key")
+	ErrInvalidToken   = errors.New("invalid bearer token")
+	ErrMethodDisabled = errors.New("authentication method is not enabled")
+)
+
+// Options configures how callers authenticate
+type Options struct {
+	APIKeysFile string      // YAML file of hashed API keys; API keys are rejected when empty
+	JWKSFile    string      // JWKS file of the keys tokens are signed with; tokens are rejected when empty
+	Issuer      string      // Required iss claim of tokens; not checked when empty
+	Audience    string      // Required aud claim of tokens; not checked when empty
+	RoleClaim   string      // Claim holding the role of token callers; DefaultRoleClaim when empty
+	TenantClaim string      // Claim holding the tenant of token callers; DefaultTenantClaim when empty
+	Clock       clock.Clock // Time tokens are validated at; the system clock when nil
+}
+
+// Authenticator authenticates callers from the API key or bearer token of their requests
+type Authenticator struct {
+	apiKeys     map[string]Principal       // By key hash
+	jwks        map[string]verificationKey // By key ID
+	parser      *jwt.Parser
+	roleClaim   string
+	tenantClaim string
+}
+
+// New creates an authenticator from the configured key files
+func New(opts Options) (*Authenticator, error) {
+	if opts.APIKeysFile == "" && opts.JWKSFile == "" {
+		return nil, fmt.Errorf("an API keys file or a JWKS file is required")
+	}
+	a := &Authenticator{roleClaim: opts.RoleClaim, tenantClaim: opts.TenantClaim}
+	if a.roleClaim == "" {
+		a.roleClaim = DefaultRoleClaim
+	}
+	if a.tenantClaim == "" {
+		a.tenantClaim = DefaultTenantClaim
+	}
+
+	if opts.APIKeysFile != "" {
+		keys, err := loadAPIKeys(opts.APIKeysFile)
+		if err != nil {
+			return nil, err
+		}
+		a.apiKeys = keys
+	}
+
+	if opts.JWKSFile != "" {
+		keys, err := loadJWKS(opts.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		a.jwks = keys
+
+		now := time.Now
+		if opts.Clock != nil {
+			now = opts.Clock.Now
+		}
+		parserOptions := []jwt.ParserOption{
+			jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"}),
+			jwt.WithExpirationRequired(),
+			jwt.WithLeeway(jwtLeeway),
+			jwt.WithTimeFunc(now),
+		}
+		if opts.Issuer != "" {
+			parserOptions = append(parserOptions, jwt.WithIssuer(opts.Issuer))
+		}
+		if opts.Audience != "" {
+			parserOptions = append(parserOptions, jwt.WithAudience(opts.Audience))
+		}
+		a.parser = jwt.NewParser(parserOptions...)
+	}
+	return a, nil
+}
+
+// Authenticate identifies the caller of a request from its x-api-key metadata or
+// its authorization bearer token
+func (a *Authenticator) Authenticate(ctx context.Context) (Principal, error) {
+	if values := metadata.ValueFromIncomingContext(ctx, APIKeyKey); len(values) > 0 {
+		return a.authenticateAPIKey(values[0])
+	}
+	for _, value := range metadata.ValueFromIncomingContext(ctx, "authorization") {
+		scheme, token, found := strings.Cut(value, " ")
+		if found && strings.EqualFold(scheme, "bearer") {
+			return a.authenticateToken(strings.TrimSpace(token))
+		}
+	}
+	return Principal{}, ErrNoCredentials
+}
+
+// authenticateAPIKey identifies the client holding an API key
+func (a *Authenticator) authenticateAPIKey(key string) (Principal, error) {
+	if a.apiKeys == nil {
+		return Principal{}, fmt.Errorf("API keys: %w", ErrMethodDisabled)
+	}
+	principal, exists := a.apiKeys[HashAPIKey(key)]
+	if !exists {
+		return Principal{}, ErrInvalidAPIKey
+	}
+	return principal, nil
+}
+
+// authenticateToken verifies a JWT against the JWKS and reads the caller's role and
+// tenant from it
+func (a *Authenticator) authenticateToken(token string) (Principal, error) {
+	if a.jwks == nil {
+		return Principal{}, fmt.Errorf("bearer tokens: %w", ErrMethodDisabled)
+	}
+
+	claims := jwt.MapClaims{}
+	if _, err := a.parser.ParseWithClaims(token, claims, a.verificationKey); err != nil {
+		return Principal{}, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	subject, _ := claims.GetSubject()
+	if subject == "" {
+		return Principal{}, fmt.Errorf("%w: no subject", ErrInvalidToken)
+	}
+	role := a.role(claims)
+	if role == "" {
+		return Principal{}, fmt.Errorf("%w: no known role in the %q claim", ErrInvalidToken, a.roleClaim)
+	}
+	tenantID, _ := claims[a.tenantClaim].(string)
+	if tenantID != "" && !tenant.ValidID(tenantID) {
+		return Principal{}, fmt.Errorf("%w: invalid tenant %q in the %q claim", ErrInvalidToken, tenantID, a.tenantClaim)
+	}
+	return Principal{Subject: subject, Role: role, Method: MethodJWT, Tenant: tenantID}, nil
+}
+
+// verificationKey returns the JWKS key a token names in its kid header. Tokens
+// without a key ID are accepted when the JWKS holds a single key.
+func (a *Authenticator) verificationKey(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+	key, exists := a.jwks[kid]
+	if !exists && kid == "" && len(a.jwks) == 1 {
+		for _, only := range a.jwks {
+			key, exists = only, true
+		}
+	}
+	if !exists {
+		return nil, fmt.Errorf("unknown key ID %q", kid)
+	}
+	if key.alg != "" && key.alg != token.Method.Alg() {
+		return nil, fmt.Errorf("key %q is restricted to %s", kid, key.alg)
+	}
+	return key.key, nil
+}
+
+// role returns the highest known role of the role claim, which holds a role or a list of roles
+func (a *Authenticator) role(claims jwt.MapClaims) Role {
+	var candidates []Role
+	switch value := claims[a.roleClaim].(type) {
+	case string:
+		candidates = append(candidates, Role(value))
+	case []any:
+		for _, item := range value {
+			if name, ok := item.(string); ok {
+				candidates = append(candidates, Role(name))
+			}
+		}
+	}
+
+	var best Role
+	for _, role := range candidates {
+		if role.Valid() && roleRanks[role] > roleRanks[best] {
+			best = role
+		}
+	}
+	return best
+}
+
+// Policy maps the full gRPC method names of a server to the role needed to call them
+type Policy struct {
+	Public   []string        // Prefixes of methods callable without credentials, e.g. health checks
+	Required map[string]Role // Role required by each method
+	Default  Role            // Role required by methods missing from Required
+}
+
+// required returns the role needed to call a method, or "" for public methods
+func (p Policy) required(method string) Role {
+	for _, prefix := range p.Public {
+		if strings.HasPrefix(method, prefix) {
+			return ""
+		}
+	}
+	if role, exists := p.Required[method]; exists {
+		return role
+	}
+	return p.Default
+}
+
+// authorize authenticates the caller of a method and checks that its role allows
+// the call, returning a context carrying the principal
+func (a *Authenticator) authorize(ctx context.Context, policy Policy, method string) (context.Context, error) {
+	required := policy.required(method)
+	if required == "" {
+		return ctx, nil
+	}
+
+	principal, err := a.Authenticate(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if !principal.Role.Allows(required) {
+		return nil, status.Errorf(codes.PermissionDenied, "%s role cannot call %s, which requires the %s role", principal.Role, method, required)
+	}
+	return WithPrincipal(ctx, principal), nil
+}
+
+// UnaryServerInterceptor rejects unary RPCs whose caller is not authenticated or
+// whose role does not allow the method
+func UnaryServerInterceptor(a *Authenticator, policy Policy) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := a.authorize(ctx, policy, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor rejects streaming RPCs whose caller is not authenticated
+// or whose role does not allow the method
+func StreamServerInterceptor(a *Authenticator, policy Policy) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authorize(ss.Context(), policy, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authenticatedStream carries the principal of a streaming RPC in its context
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
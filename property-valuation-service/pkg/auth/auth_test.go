@@ -0,0 +1,353 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/jsarcade/property-valuation-service/pkg/clock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var testNow = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+// testKeys holds the private keys the JWKS written by writeJWKS verifies
+type testKeys struct {
+	rsa     *rsa.PrivateKey
+	ec      *ecdsa.PrivateKey
+	ed25519 ed25519.PrivateKey
+}
+
+func newTestKeys(t *testing.T) testKeys {
+	t.Helper()
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate RSA key: %v", err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate EC key: %v", err)
+	}
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate Ed25519 key: %v", err)
+	}
+	return testKeys{rsa: rsaKey, ec: ecKey, ed25519: edKey}
+}
+
+func encodeInt(n *big.Int) string {
+	return base64.RawURLEncoding.EncodeToString(n.Bytes())
+}
+
+// writeJWKS writes the public keys as a JWKS file with the key IDs rsa, ec and ed
+func writeJWKS(t *testing.T, keys testKeys) string {
+	t.Helper()
+	set := map[string][]jwk{"keys": {
+		{Kty: "RSA", Kid: "rsa", Use: "sig", Alg: "RS256", N: encodeInt(keys.rsa.N), E: encodeInt(big.NewInt(int64(keys.rsa.E)))},
+		{Kty: "EC", Kid: "ec", Crv: "P-256", X: encodeInt(keys.ec.X), Y: encodeInt(keys.ec.Y)},
+		{Kty: "OKP", Kid: "ed", Crv: "Ed25519", X: base64.RawURLEncoding.EncodeToString(keys.ed25519.Public().(ed25519.PublicKey))},
+	}}
+	data, err := json.Marshal(set)
+	if err != nil {
+		t.Fatalf("Failed to encode JWKS: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatalf("Failed to write JWKS: %v", err)
+	}
+	return path
+}
+
+// writeAPIKeys writes an API keys file
+func writeAPIKeys(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "api_keys.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("Failed to write API keys: %v", err)
+	}
+	return path
+}
+
+// sign returns a token signed with the key and method, naming kid in its header unless empty
+func sign(t *testing.T, method jwt.SigningMethod, key crypto.Signer, kid string, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("Failed to sign token: %v", err)
+	}
+	return signed
+}
+
+func claims(overrides jwt.MapClaims) jwt.MapClaims {
+	c := jwt.MapClaims{
+		"sub":  "alice",
+		"iss":  "https://login.example.com",
+		"aud":  "valuation",
+		"exp":  testNow.Add(time.Hour).Unix(),
+		"role": "appraiser",
+	}
+	for name, value := range overrides {
+		if value == nil {
+			delete(c, name)
+		} else {
+			c[name] = value
+		}
+	}
+	return c
+}
+
+func incoming(pairs ...string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(pairs...))
+}
+
+func TestRoleAllows(t *testing.T) {
+	tests := []struct {
+		role     Role
+		required Role
+		want     bool
+	}{
+		{RoleViewer, RoleViewer, true},
+		{RoleViewer, RoleAppraiser, false},
+		{RoleAppraiser, RoleViewer, true},
+		{RoleAppraiser, RoleAdmin, false},
+		{RoleAdmin, RoleAppraiser, true},
+		{Role("owner"), RoleViewer, false},
+	}
+	for _, tt := range tests {
+		if got := tt.role.Allows(tt.required); got != tt.want {
+			t.Errorf("%s.Allows(%s) = %v, want %v", tt.role, tt.required, got, tt.want)
+		}
+	}
+}
+
+func TestAuthenticateToken(t *testing.T) {
+	keys := newTestKeys(t)
+	a, err := New(Options{
+		JWKSFile: writeJWKS(t, keys),
+		Issuer:   "https://login.example.com",
+		Audience: "valuation",
+		Clock:    clock.NewFake(testNow),
+	})
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		token    string
+		wantRole Role // "" when the token must be rejected
+	}{
+		{"RSA", sign(t, jwt.SigningMethodRS256, keys.rsa, "rsa", claims(nil)), RoleAppraiser},
+		{"EC", sign(t, jwt.SigningMethodES256, keys.ec, "ec", claims(jwt.MapClaims{"role": "admin"})), RoleAdmin},
+		{"Ed25519", sign(t, jwt.SigningMethodEdDSA, keys.ed25519, "ed", claims(jwt.MapClaims{"role": "viewer"})), RoleViewer},
+		{"Highest of several roles", sign(t, jwt.SigningMethodRS256, keys.rsa, "rsa", claims(jwt.MapClaims{"role": []any{"viewer", "admin", "owner"}})), RoleAdmin},
+		{"Expired within leeway", sign(t, jwt.SigningMethodRS256, keys.rsa, "rsa", claims(jwt.MapClaims{"exp": testNow.Add(-10 * time.Second).Unix()})), RoleAppraiser},
+		{"Expired", sign(t, jwt.SigningMethodRS256, keys.rsa, "rsa", claims(jwt.MapClaims{"exp": testNow.Add(-time.Hour).Unix()})), ""},
+		{"No expiry", sign(t, jwt.SigningMethodRS256, keys.rsa, "rsa", claims(jwt.MapClaims{"exp": nil})), ""},
+		{"Not yet valid", sign(t, jwt.SigningMethodRS256, keys.rsa, "rsa", claims(jwt.MapClaims{"nbf": testNow.Add(time.Hour).Unix()})), ""},
+		{"Wrong issuer", sign(t, jwt.SigningMethodRS256, keys.rsa, "rsa", claims(jwt.MapClaims{"iss": "https://evil.example.com"})), ""},
+		{"Wrong audience", sign(t, jwt.SigningMethodRS256, keys.rsa, "rsa", claims(jwt.MapClaims{"aud": "billing"})), ""},
+		{"Unknown role", sign(t, jwt.SigningMethodRS256, keys.rsa, "rsa", claims(jwt.MapClaims{"role": "owner"})), ""},
+		{"Invalid tenant", sign(t, jwt.SigningMethodRS256, keys.rsa, "rsa", claims(jwt.MapClaims{"tenant": "*"})), ""},
+		{"No subject", sign(t, jwt.SigningMethodRS256, keys.rsa, "rsa", claims(jwt.MapClaims{"sub": nil})), ""},
+		{"Unknown key ID", sign(t, jwt.SigningMethodRS256, keys.rsa, "other", claims(nil)), ""},
+		{"Key ID of another key", sign(t, jwt.SigningMethodES256, keys.ec, "ed", claims(nil)), ""},
+		{"Algorithm the key is not restricted to", sign(t, jwt.SigningMethodPS256, keys.rsa, "rsa", claims(nil)), ""},
+		{"No key ID with several keys", sign(t, jwt.SigningMethodRS256, keys.rsa, "", claims(nil)), ""},
+		{"Malformed", "not.a.token", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			principal, err := a.Authenticate(incoming("authorization", "Bearer "+tt.token))
+			if tt.wantRole == "" {
+				if !errors.Is(err, ErrInvalidToken) {
+					t.Errorf("Authenticate error = %v, want %v", err, ErrInvalidToken)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Authenticate failed: %v", err)
+			}
+			want := Principal{Subject: "alice", Role: tt.wantRole, Method: MethodJWT}
+			if principal != want {
+				t.Errorf("Principal = %+v, want %+v", principal, want)
+			}
+		})
+	}
+
+	t.Run("Tenant", func(t *testing.T) {
+		token := sign(t, jwt.SigningMethodRS256, keys.rsa, "rsa", claims(jwt.MapClaims{"tenant": "acme"}))
+		principal, err := a.Authenticate(incoming("authorization", "Bearer "+token))
+		if err != nil {
+			t.Fatalf("Authenticate failed: %v", err)
+		}
+		if principal.Tenant != "acme" {
+			t.Errorf("Tenant = %q, want acme", principal.Tenant)
+		}
+	})
+
+	// A token signed with HMAC using the public key as secret must not be accepted
+	hmac := jwt.NewWithClaims(jwt.SigningMethodHS256, claims(nil))
+	hmac.Header["kid"] = "rsa"
+	forged, err := hmac.SignedString([]byte(encodeInt(keys.rsa.N)))
+	if err != nil {
+		t.Fatalf("Failed to sign token: %v", err)
+	}
+	if _, err := a.Authenticate(incoming("authorization", "Bearer "+forged)); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("Authenticate error = %v for an HMAC token, want %v", err, ErrInvalidToken)
+	}
+}
+
+func TestAuthenticateAPIKey(t *testing.T) {
+	path := writeAPIKeys(t, `keys:
+  - name: crm
+    hash: `+HashAPIKey("crm-secret")+`
+    role: appraiser
+    tenant: acme
+  - name: dashboard
+    hash: `+HashAPIKey("dashboard-secret")+`
+    role: viewer
+`)
+	a, err := New(Options{APIKeysFile: path})
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		want    Principal
+		wantErr error
+	}{
+		{"Appraiser key", incoming(APIKeyKey, "crm-secret"), Principal{Subject: "crm", Role: RoleAppraiser, Method: MethodAPIKey, Tenant: "acme"}, nil},
+		{"Viewer key", incoming(APIKeyKey, "dashboard-secret"), Principal{Subject: "dashboard", Role: RoleViewer, Method: MethodAPIKey}, nil},
+		{"Unknown key", incoming(APIKeyKey, "guess"), Principal{}, ErrInvalidAPIKey},
+		{"No credentials", incoming(), Principal{}, ErrNoCredentials},
+		{"Basic authorization", incoming("authorization", "Basic Y3JtOnNlY3JldA=="), Principal{}, ErrNoCredentials},
+		{"Bearer token without JWKS", incoming("authorization", "Bearer abc"), Principal{}, ErrMethodDisabled},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			principal, err := a.Authenticate(tt.ctx)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Authenticate error = %v, want %v", err, tt.wantErr)
+			}
+			if principal != tt.want {
+				t.Errorf("Principal = %+v, want %+v", principal, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadAPIKeysErrors(t *testing.T) {
+	hash := HashAPIKey("secret")
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"Unknown field", "keys:\n  - name: crm\n    hash: " + hash + "\n    role: admin\n    secret: x\n"},
+		{"Missing name", "keys:\n  - hash: " + hash + "\n    role: admin\n"},
+		{"Duplicate name", "keys:\n  - name: crm\n    hash: " + hash + "\n    role: admin\n  - name: crm\n    hash: " + HashAPIKey("other") + "\n    role: admin\n"},
+		{"Clear key", "keys:\n  - name: crm\n    hash: secret\n    role: admin\n"},
+		{"Unknown role", "keys:\n  - name: crm\n    hash: " + hash + "\n    role: owner\n"},
+		{"Invalid tenant", "keys:\n  - name: crm\n    hash: " + hash + "\n    role: appraiser\n    tenant: \"*\"\n"},
+		{"Duplicate key", "keys:\n  - name: crm\n    hash: " + hash + "\n    role: admin\n  - name: erp\n    hash: " + hash + "\n    role: viewer\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := loadAPIKeys(writeAPIKeys(t, tt.content)); err == nil {
+				t.Errorf("loadAPIKeys succeeded, want an error")
+			}
+		})
+	}
+}
+
+func TestParseJWKSErrors(t *testing.T) {
+	small, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("Failed to generate RSA key: %v", err)
+	}
+	tests := []struct {
+		name string
+		jwks string
+	}{
+		{"Not JSON", "keys"},
+		{"No keys", `{"keys": []}`},
+		{"Only encryption keys", `{"keys": [{"kty": "OKP", "use": "enc", "crv": "X25519", "x": "AAAA"}]}`},
+		{"Short RSA key", `{"keys": [{"kty": "RSA", "n": "` + encodeInt(small.N) + `", "e": "AQAB"}]}`},
+		{"Point off the curve", `{"keys": [{"kty": "EC", "crv": "P-256", "x": "AQ", "y": "AQ"}]}`},
+		{"Unsupported key type", `{"keys": [{"kty": "oct", "k": "c2VjcmV0"}]}`},
+		{"Duplicate key ID", `{"keys": [{"kty": "OKP", "kid": "a", "crv": "Ed25519", "x": "` + base64.RawURLEncoding.EncodeToString(make([]byte, 32)) + `"}, {"kty": "OKP", "kid": "a", "crv": "Ed25519", "x": "` + base64.RawURLEncoding.EncodeToString(make([]byte, 32)) + `"}]}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := parseJWKS([]byte(tt.jwks)); err == nil {
+				t.Errorf("parseJWKS succeeded, want an error")
+			}
+		})
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	a, err := New(Options{APIKeysFile: writeAPIKeys(t, "keys:\n  - name: dashboard\n    hash: "+HashAPIKey("viewer-secret")+"\n    role: viewer\n")})
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	policy := Policy{
+		Public:   []string{"/grpc.health.v1.Health/"},
+		Required: map[string]Role{"/svc/Get": RoleViewer, "/svc/Calculate": RoleAppraiser},
+		Default:  RoleAdmin,
+	}
+	interceptor := UnaryServerInterceptor(a, policy)
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		method   string
+		wantCode codes.Code
+	}{
+		{"Allowed", incoming(APIKeyKey, "viewer-secret"), "/svc/Get", codes.OK},
+		{"Role too low", incoming(APIKeyKey, "viewer-secret"), "/svc/Calculate", codes.PermissionDenied},
+		{"Unlisted method", incoming(APIKeyKey, "viewer-secret"), "/svc/Reload", codes.PermissionDenied},
+		{"No credentials", incoming(), "/svc/Get", codes.Unauthenticated},
+		{"Public", incoming(), "/grpc.health.v1.Health/Check", codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var called bool
+			_, err := interceptor(tt.ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, func(ctx context.Context, req any) (any, error) {
+				called = true
+				if principal, ok := PrincipalFromContext(ctx); ok && principal.Subject != "dashboard" {
+					t.Errorf("Principal = %+v, want dashboard", principal)
+				}
+				return nil, nil
+			})
+			if code := status.Code(err); code != tt.wantCode {
+				t.Errorf("Code = %v, want %v (%v)", code, tt.wantCode, err)
+			}
+			if called != (tt.wantCode == codes.OK) {
+				t.Errorf("Handler called = %v, want %v", called, tt.wantCode == codes.OK)
+			}
+		})
+	}
+}
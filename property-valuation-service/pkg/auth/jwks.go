@@ -0,0 +1,143 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+)
+
+// jwk represents a public JSON Web Key (RFC 7517) of a JWKS file
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`   // RSA modulus
+	E   string `json:"e"`   // RSA exponent
+	Crv string `json:"crv"` // EC or OKP curve
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// verificationKey represents a public key tokens may be signed with
+type verificationKey struct {
+	key crypto.PublicKey
+	alg string // Signing algorithm the key is restricted to; any matching algorithm when empty
+}
+
+// loadJWKS loads the RSA, EC and Ed25519 signing keys of a JWKS file, keyed by key ID.
+// Encryption keys are ignored.
+func loadJWKS(path string) (map[string]verificationKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading JWKS: %w", err)
+	}
+	keys, err := parseJWKS(data)
+	if err != nil {
+		return nil, fmt.Errorf("parsing JWKS %s: %w", path, err)
+	}
+	return keys, nil
+}
+
+// parseJWKS parses the signing keys of a JWK set
+func parseJWKS(data []byte) (map[string]verificationKey, error) {
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]verificationKey)
+	for i, k := range set.Keys {
+		if k.Use == "enc" {
+			continue
+		}
+		if _, exists := keys[k.Kid]; exists {
+			return nil, fmt.Errorf("key %d: duplicate key ID %q", i, k.Kid)
+		}
+		key, err := k.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("key %d (%q): %w", i, k.Kid, err)
+		}
+		keys[k.Kid] = verificationKey{key: key, alg: k.Alg}
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no signing keys")
+	}
+	return keys, nil
+}
+
+// publicKey decodes the public key of a JWK
+func (k jwk) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeInt(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("invalid modulus: %w", err)
+		}
+		e, err := decodeInt(k.E)
+		if err != nil || !e.IsInt64() || e.Int64() < 3 || e.Int64() > 1<<31-1 {
+			return nil, fmt.Errorf("invalid exponent %q", k.E)
+		}
+		if n.BitLen() < 2048 {
+			return nil, fmt.Errorf("RSA keys must have at least 2048 bits, got %d", n.BitLen())
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, errX := decodeInt(k.X)
+		y, errY := decodeInt(k.Y)
+		if errX != nil || errY != nil {
+			return nil, fmt.Errorf("invalid coordinates")
+		}
+		key := &ecdsa.PublicKey{Curve: curve, X: x, Y: y}
+		if _, err := key.ECDH(); err != nil {
+			return nil, fmt.Errorf("invalid point: %w", err)
+		}
+		return key, nil
+
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid Ed25519 key")
+		}
+		return ed25519.PublicKey(x), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+// decodeInt decodes a base64url-encoded big-endian unsigned integer
+func decodeInt(s string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf("empty value")
+	}
+	return new(big.Int).SetBytes(data), nil
+}
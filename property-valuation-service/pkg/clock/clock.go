@@ -0,0 +1,53 @@
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock tells the current time. Code that depends on the date, such as property
+// ages and condition windows, reads it from an injected clock rather than calling
+// time.Now so that it stays correct in long-running processes and testable.
+type Clock interface {
+	Now() time.Time
+}
+
+// System is the clock backed by the system's wall clock
+type System struct{}
+
+// Now returns the current system time
+func (System) Now() time.Time {
+	return time.Now()
+}
+
+// Fake is a clock that only moves when told to, for deterministic tests
+type Fake struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewFake creates a fake clock stopped at the given time
+func NewFake(now time.Time) *Fake {
+	return &Fake{now: now}
+}
+
+// Now returns the time the fake clock is stopped at
+func (f *Fake) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+// Set moves the fake clock to the given time
+func (f *Fake) Set(now time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = now
+}
+
+// Advance moves the fake clock forward by the given duration
+func (f *Fake) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = f.now.Add(d)
+}
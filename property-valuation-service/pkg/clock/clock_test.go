@@ -0,0 +1,32 @@
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFake(t *testing.T) {
+	start := time.Date(2026, 12, 31, 23, 0, 0, 0, time.UTC)
+	fake := NewFake(start)
+
+	if got := fake.Now(); !got.Equal(start) {
+		t.Errorf("Now() = %v, want %v", got, start)
+	}
+	if got := fake.Now(); !got.Equal(start) {
+		t.Errorf("Now() moved without being advanced: %v, want %v", got, start)
+	}
+
+	fake.Advance(2 * time.Hour)
+	if got, want := fake.Now(), start.Add(2*time.Hour); !got.Equal(want) {
+		t.Errorf("Now() after Advance = %v, want %v", got, want)
+	}
+	if year := fake.Now().Year(); year != 2027 {
+		t.Errorf("year after New Year = %d, want 2027", year)
+	}
+
+	later := time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC)
+	fake.Set(later)
+	if got := fake.Now(); !got.Equal(later) {
+		t.Errorf("Now() after Set = %v, want %v", got, later)
+	}
+}
@@ -0,0 +1,56 @@
+package comparables
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/jsarcade/property-valuation-service/pkg/valuation"
+)
+
+// Sale represents a recorded sale of a property
+type Sale struct {
+	Address       string             `json:"address"`
+	PropertyType  string             `json:"propertyType"`
+	Bedrooms      int                `json:"bedrooms"`
+	Bathrooms     int                `json:"bathrooms"`
+	SquareFootage int                `json:"squareFootage"`
+	YearBuilt     int                `json:"yearBuilt"`
+	Condition     string             `json:"condition"`
+	Features      []string           `json:"features"`
+	Location      valuation.Location `json:"location"`
+	Price         float64            `json:"price"`
+	SaleDate      time.Time          `json:"saleDate"`
+}
+
+// salesFile represents the on-disk layout of a sales dataset
+type salesFile struct {
+	Sales []Sale `json:"sales"`
+}
+
+// LoadSales reads a dataset of recent sales from a JSON file
+func LoadSales(path string) ([]Sale, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read sales dataset: %w", err)
+	}
+
+	var file salesFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse sales dataset %s: %w", path, err)
+	}
+
+	for i, sale := range file.Sales {
+		if sale.Price <= 0 {
+			return nil, fmt.Errorf("sale %d (%s): price must be positive", i, sale.Address)
+		}
+		if sale.SquareFootage <= 0 {
+			return nil, fmt.Errorf("sale %d (%s): square footage must be positive", i, sale.Address)
+		}
+		if sale.SaleDate.IsZero() {
+			return nil, fmt.Errorf("sale %d (%s): sale date is required", i, sale.Address)
+		}
+	}
+	return file.Sales, nil
+}
@@ -0,0 +1,242 @@
+package comparables
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jsarcade/property-valuation-service/pkg/location"
+	"github.com/jsarcade/property-valuation-service/pkg/valuation"
+)
+
+// ErrInsufficientComparables is returned when too few recent sales match the subject property
+var ErrInsufficientComparables = errors.New("not enough comparable sales")
+
+// Options controls how comparable sales are selected
+type Options struct {
+	MinComparables   int     // Minimum number of comparables required to reconcile a value
+	MaxComparables   int     // Maximum number of comparables used
+	MaxDistanceKm    float64 // Maximum distance between the subject and a comparable
+	MaxSaleAgeMonths int     // Maximum age of a sale relative to the valuation date
+}
+
+// DefaultOptions returns the selection options used when none are configured
+func DefaultOptions() Options {
+	return Options{
+		MinComparables:   3,
+		MaxComparables:   5,
+		MaxDistanceKm:    5,
+		MaxSaleAgeMonths: 12,
+	}
+}
+
+// Adjustment represents a dollar adjustment applied to a comparable's sale price
+// to account for a difference from the subject property
+type Adjustment struct {
+	Category string  `json:"category"` // "size", "condition", "features", "age", "bedrooms", "bathrooms"
+	Amount   float64 `json:"amount"`
+}
+
+// Comparable represents a sale used in a sales comparison valuation
+type Comparable struct {
+	Sale            Sale         `json:"sale"`
+	DistanceKm      float64      `json:"distanceKm"`
+	Adjustments     []Adjustment `json:"adjustments"`
+	AdjustedPrice   float64      `json:"adjustedPrice"`
+	GrossAdjustment float64      `json:"grossAdjustment"` // Sum of absolute adjustments as a fraction of the sale price
+	Weight          float64      `json:"weight"`          // Share of the reconciled value, 0.0 to 1.0
+}
+
+// Result represents the outcome of a sales comparison valuation
+type Result struct {
+	Value       float64      `json:"value"`
+	Confidence  float64      `json:"confidence"`
+	Comparables []Comparable `json:"comparables"`
+}
+
+// Engine values properties using the sales comparison approach
+type Engine struct {
+	sales   []Sale
+	options Options
+}
+
+// NewEngine creates a sales comparison engine over a dataset of recent sales
+func NewEngine(sales []Sale, options Options) *Engine {
+	defaults := DefaultOptions()
+	if options.MinComparables <= 0 {
+		options.MinComparables = defaults.MinComparables
+	}
+	if options.MaxComparables < options.MinComparables {
+		options.MaxComparables = max(defaults.MaxComparables, options.MinComparables)
+	}
+	if options.MaxDistanceKm <= 0 {
+		options.MaxDistanceKm = defaults.MaxDistanceKm
+	}
+	if options.MaxSaleAgeMonths <= 0 {
+		options.MaxSaleAgeMonths = defaults.MaxSaleAgeMonths
+	}
+	return &Engine{sales: sales, options: options}
+}
+
+// Value selects the nearest comparable sales for the subject property, adjusts
+// each for its differences from the subject using the pricing model tables and
+// reconciles the adjusted prices into a value as of the given date
+func (e *Engine) Value(model *valuation.PricingModel, subject valuation.Property, asOf time.Time) (Result, error) {
+	candidates := e.selectComparables(subject, asOf)
+	if len(candidates) < e.options.MinComparables {
+		return Result{}, fmt.Errorf("%w: found %d within %.1f km sold in the last %d months, need %d",
+			ErrInsufficientComparables, len(candidates), e.options.MaxDistanceKm, e.options.MaxSaleAgeMonths, e.options.MinComparables)
+	}
+
+	totalWeight := 0.0
+	for i := range candidates {
+		comp := &candidates[i]
+		comp.Adjustments = adjustments(model, subject, comp.Sale, asOf.Year())
+
+		comp.AdjustedPrice = comp.Sale.Price
+		gross := 0.0
+		for _, adjustment := range comp.Adjustments {
+			comp.AdjustedPrice += adjustment.Amount
+			gross += math.Abs(adjustment.Amount)
+		}
+		comp.GrossAdjustment = gross / comp.Sale.Price
+
+		// Comparables that needed less adjustment are more reliable indicators
+		comp.Weight = 1.0 / (comp.GrossAdjustment + 0.05)
+		totalWeight += comp.Weight
+	}
+
+	result := Result{Comparables: candidates}
+	averageGross := 0.0
+	for i := range result.Comparables {
+		comp := &result.Comparables[i]
+		comp.Weight /= totalWeight
+		result.Value += comp.AdjustedPrice * comp.Weight
+		averageGross += comp.GrossAdjustment / float64(len(result.Comparables))
+	}
+
+	// More comparables and smaller adjustments give a more reliable value
+	confidence := 0.60 + 0.05*float64(len(result.Comparables)) - averageGross*0.5
+	result.Confidence = math.Max(0.5, math.Min(0.95, confidence))
+
+	return result, nil
+}
+
+// selectComparables returns the most similar recent sales of the same property type,
+// ordered from most to least similar
+func (e *Engine) selectComparables(subject valuation.Property, asOf time.Time) []Comparable {
+	oldest := asOf.AddDate(0, -e.options.MaxSaleAgeMonths, 0)
+
+	type candidate struct {
+		comp  Comparable
+		score float64
+	}
+	var candidates []candidate
+	for _, sale := range e.sales {
+		if sale.PropertyType != subject.PropertyType || sale.SaleDate.Before(oldest) || sale.SaleDate.After(asOf) {
+			continue
+		}
+		distance := location.DistanceKm(subject.Location, sale.Location)
+		if distance > e.options.MaxDistanceKm {
+			continue
+		}
+
+		// Lower scores are more similar: distance, size and age differences are
+		// each normalised so that a "large" difference contributes about 1.0
+		sizeDiff := math.Abs(float64(subject.SquareFootage-sale.SquareFootage)) / float64(subject.SquareFootage)
+		ageDiff := math.Abs(float64(subject.YearBuilt-sale.YearBuilt)) / 20.0
+		score := distance/e.options.MaxDistanceKm + sizeDiff + ageDiff
+
+		candidates = append(candidates, candidate{
+			comp:  Comparable{Sale: sale, DistanceKm: distance},
+			score: score,
+		})
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].score < candidates[j].score
+	})
+
+	comps := make([]Comparable, 0, e.options.MaxComparables)
+	for _, c := range candidates {
+		if len(comps) == e.options.MaxComparables {
+			break
+		}
+		comps = append(comps, c.comp)
+	}
+	return comps
+}
+
+// adjustments computes the adjustments that make a comparable sale equivalent to the subject
+func adjustments(model *valuation.PricingModel, subject valuation.Property, sale Sale, currentYear int) []Adjustment {
+	var result []Adjustment
+	add := func(category string, amount float64) {
+		if amount != 0 {
+			result = append(result, Adjustment{Category: category, Amount: amount})
+		}
+	}
+
+	// Size difference priced at the model's price per square foot
+	add("size", float64(subject.SquareFootage-sale.SquareFootage)*model.BasePricePerSquareFoot[subject.PropertyType])
+
+	// Condition difference as the ratio of condition multipliers
+	subjectCondition, subjectKnown := model.ConditionCriteria[subject.Condition]
+	saleCondition, saleKnown := model.ConditionCriteria[sale.Condition]
+	if subjectKnown && saleKnown {
+		add("condition", sale.Price*(subjectCondition.Multiplier/saleCondition.Multiplier-1))
+	}
+
+	// Features the subject has that the sale lacks add value and vice versa
+	features := 0.0
+	for _, feature := range subject.Features {
+		if !containsFeature(sale.Features, feature) {
+			features += model.FeatureValue[feature]
+		}
+	}
+	for _, feature := range sale.Features {
+		if !containsFeature(subject.Features, feature) {
+			features -= model.FeatureValue[feature]
+		}
+	}
+	add("features", features)
+
+	// Age difference as the ratio of depreciation factors
+	add("age", sale.Price*(valuation.AgeDepreciation(subject.YearBuilt, currentYear)/
+		valuation.AgeDepreciation(sale.YearBuilt, currentYear)-1))
+
+	add("bedrooms", float64(subject.Bedrooms-sale.Bedrooms)*valuation.BedroomValue)
+	add("bathrooms", float64(subject.Bathrooms-sale.Bathrooms)*valuation.BathroomValue)
+
+	return result
+}
+
+func containsFeature(features []string, feature string) bool {
+	for _, f := range features {
+		if f == feature {
+			return true
+		}
+	}
+	return false
+}
+
+// Explanation renders the sales comparison result as human-readable text
+func (r Result) Explanation() string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "Sales comparison based on %d comparable sales:\n", len(r.Comparables))
+	for _, comp := range r.Comparables {
+		fmt.Fprintf(&sb, "- %s sold %s for $%.2f (%.2f km away)\n",
+			comp.Sale.Address, comp.Sale.SaleDate.Format("2006-01-02"), comp.Sale.Price, comp.DistanceKm)
+		for _, adjustment := range comp.Adjustments {
+			fmt.Fprintf(&sb, "  * %s: %+.2f\n", adjustment.Category, adjustment.Amount)
+		}
+		fmt.Fprintf(&sb, "  Adjusted price: $%.2f (gross adjustment %.0f%%, weight %.2f)\n",
+			comp.AdjustedPrice, comp.GrossAdjustment*100, comp.Weight)
+	}
+	fmt.Fprintf(&sb, "- Reconciled value: $%.2f\n", r.Value)
+
+	return sb.String()
+}
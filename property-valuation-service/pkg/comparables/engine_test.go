@@ -0,0 +1,137 @@
+package comparables
+
+import (
+	"errors"
+	"math"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jsarcade/property-valuation-service/pkg/valuation"
+)
+
+var asOf = time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC)
+
+func testSubject() valuation.Property {
+	return valuation.Property{
+		Address:       "12 Elm St",
+		PropertyType:  "house",
+		Bedrooms:      3,
+		Bathrooms:     2,
+		SquareFootage: 2000,
+		YearBuilt:     2010,
+		Condition:     "good",
+		Features:      []string{"garage", "pool"},
+		Location:      valuation.Location{Latitude: 25.7000, Longitude: -80.3000},
+	}
+}
+
+// saleLike returns a sale identical to the subject, sold for price months before asOf
+func saleLike(subject valuation.Property, price float64, monthsAgo int) Sale {
+	return Sale{
+		Address:       "comp",
+		PropertyType:  subject.PropertyType,
+		Bedrooms:      subject.Bedrooms,
+		Bathrooms:     subject.Bathrooms,
+		SquareFootage: subject.SquareFootage,
+		YearBuilt:     subject.YearBuilt,
+		Condition:     subject.Condition,
+		Features:      subject.Features,
+		Location:      subject.Location,
+		Price:         price,
+		SaleDate:      asOf.AddDate(0, -monthsAgo, 0),
+	}
+}
+
+func TestValueIdenticalComparables(t *testing.T) {
+	subject := testSubject()
+	sales := []Sale{saleLike(subject, 600000, 1), saleLike(subject, 600000, 2), saleLike(subject, 600000, 3)}
+
+	result, err := NewEngine(sales, DefaultOptions()).Value(valuation.BuiltinModel(), subject, asOf)
+	if err != nil {
+		t.Fatalf("Value failed: %v", err)
+	}
+	if math.Abs(result.Value-600000) > 0.01 {
+		t.Errorf("Value = %.2f, want 600000", result.Value)
+	}
+	for _, comp := range result.Comparables {
+		if len(comp.Adjustments) != 0 || math.Abs(comp.Weight-1.0/3) > 1e-9 {
+			t.Errorf("Identical comparable has adjustments %v and weight %.3f", comp.Adjustments, comp.Weight)
+		}
+	}
+}
+
+func TestAdjustments(t *testing.T) {
+	model := valuation.BuiltinModel()
+	subject := testSubject()
+
+	sale := saleLike(subject, 600000, 1)
+	sale.SquareFootage = 2100
+	sale.Features = []string{"garage"}
+	sale.Condition = "fair"
+	sale.Bedrooms = 4
+
+	amounts := make(map[string]float64)
+	for _, adjustment := range adjustments(model, subject, sale, asOf.Year()) {
+		amounts[adjustment.Category] = adjustment.Amount
+	}
+
+	want := map[string]float64{
+		"size":      -100 * model.BasePricePerSquareFoot["house"],
+		"features":  model.FeatureValue["pool"],
+		"condition": 600000 * (model.ConditionCriteria["good"].Multiplier/model.ConditionCriteria["fair"].Multiplier - 1),
+		"bedrooms":  -valuation.BedroomValue,
+	}
+	if len(amounts) != len(want) {
+		t.Errorf("Adjustments = %v, want %v", amounts, want)
+	}
+	for category, amount := range want {
+		if math.Abs(amounts[category]-amount) > 0.01 {
+			t.Errorf("%s adjustment = %.2f, want %.2f", category, amounts[category], amount)
+		}
+	}
+}
+
+func TestSelectComparables(t *testing.T) {
+	subject := testSubject()
+
+	far := saleLike(subject, 500000, 1)
+	far.Location.Latitude += 0.5
+	condo := saleLike(subject, 500000, 1)
+	condo.PropertyType = "condo"
+	stale := saleLike(subject, 500000, 18)
+	future := saleLike(subject, 500000, -1)
+	bigger := saleLike(subject, 500000, 1)
+	bigger.SquareFootage = 3000
+
+	sales := []Sale{far, condo, stale, future, bigger}
+	for i := 0; i < 6; i++ {
+		sales = append(sales, saleLike(subject, 600000, 1))
+	}
+
+	engine := NewEngine(sales, Options{MinComparables: 3, MaxComparables: 5})
+	comps := engine.selectComparables(subject, asOf)
+	if len(comps) != 5 {
+		t.Fatalf("Selected %d comparables, want 5", len(comps))
+	}
+	for _, comp := range comps {
+		if comp.Sale.Price != 600000 {
+			t.Errorf("Selected unexpected comparable %+v", comp.Sale)
+		}
+	}
+
+	_, err := NewEngine(sales[:5], DefaultOptions()).Value(valuation.BuiltinModel(), subject, asOf)
+	if !errors.Is(err, ErrInsufficientComparables) {
+		t.Errorf("Value with one eligible sale: error = %v, want ErrInsufficientComparables", err)
+	}
+}
+
+func TestLoadSales(t *testing.T) {
+	sales, err := LoadSales(filepath.Join("..", "..", "data", "recent_sales.json"))
+	if err != nil {
+		t.Fatalf("LoadSales failed: %v", err)
+	}
+	if len(sales) == 0 {
+		t.Error("Expected sales in the sample dataset")
+	}
+}
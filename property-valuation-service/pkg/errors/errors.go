@@ -2,6 +2,9 @@ package errors
 
 import (
 	"fmt"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -16,24 +19,99 @@ func (e *ValidationError) Error() string {
 	return fmt.Sprintf("validation error: %s - %s", e.Field, e.Message)
 }
 
+// ValidationErrors represents every validation error found in a request
+type ValidationErrors []*ValidationError
+
+func (e ValidationErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, fmt.Sprintf("%s - %s", err.Field, err.Message))
+	}
+	return fmt.Sprintf("validation failed: %s", strings.Join(messages, "; "))
+}
+
 // ConvertToGRPCError converts internal errors to gRPC errors
 func ConvertToGRPCError(err error) error {
 	switch e := err.(type) {
 	case *ValidationError:
-		return status.Error(codes.InvalidArgument, e.Error())
+		return invalidArgument(e.Error(), ValidationErrors{e})
+	case ValidationErrors:
+		return invalidArgument(e.Error(), e)
 	default:
 		return status.Error(codes.Internal, "internal server error")
 	}
 }
 
+// invalidArgument builds an InvalidArgument status carrying a google.rpc.BadRequest
+// detail with one field violation per validation error
+func invalidArgument(message string, violations ValidationErrors) error {
+	badRequest := &errdetails.BadRequest{}
+	for _, v := range violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Message,
+		})
+	}
+
+	st := status.New(codes.InvalidArgument, message)
+	detailed, err := st.WithDetails(badRequest)
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
 // Common validation error messages
 const (
-	ErrInvalidPropertyType     = "invalid property type"
-	ErrInvalidCondition        = "invalid condition"
-	ErrInvalidMaintenanceLevel = "invalid maintenance level"
-	ErrInvalidRenovationStatus = "invalid renovation status"
-	ErrInvalidYearBuilt        = "invalid year built"
-	ErrInvalidSquareFootage    = "invalid square footage"
-	ErrInvalidBedrooms         = "invalid number of bedrooms"
-	ErrInvalidBathrooms        = "invalid number of bathrooms"
-) 
\ No newline at end of file
+	ErrMissingProperty          = "property is required"
+	ErrInvalidPropertyType      = "invalid property type"
+	ErrInvalidCondition         = "invalid condition"
+	ErrInvalidMaintenanceLevel  = "invalid maintenance level"
+	ErrInvalidRenovationStatus  = "invalid renovation status"
+	ErrInvalidYearBuilt         = "invalid year built"
+	ErrInvalidSquareFootage     = "invalid square footage"
+	ErrInvalidBedrooms          = "invalid number of bedrooms"
+	ErrInvalidBathrooms         = "invalid number of bathrooms"
+	ErrInvalidLocation          = "invalid location coordinates"
+	ErrLocationRequired         = "location is required for the sales comparison approach"
+	ErrIncomeDataRequired       = "income data is required for the income approach"
+	ErrIncomeNotApplicable      = "the income approach only applies to commercial property types"
+	ErrInvalidRentRoll          = "rent roll must contain at least one lease with non-negative rent"
+	ErrInvalidOtherIncome       = "other income must not be negative"
+	ErrInvalidVacancyRate       = "vacancy rate must be between 0 and 1"
+	ErrInvalidOperatingExpenses = "operating expenses must not be negative"
+	ErrInvalidCapRate           = "cap rate must be greater than 0 and less than 1"
+	ErrInvalidHoldingPeriod     = "holding period must be between 1 and 30 years"
+	ErrInvalidDiscountRate      = "discount rate must be greater than 0 and less than 1"
+	ErrInvalidGrowthRate        = "growth rate must be between -0.5 and 0.5"
+	ErrInvalidSellingCostRate   = "selling cost rate must be between 0 and 1"
+	ErrInvalidConfidenceLevel   = "confidence level must be greater than 0 and less than 1"
+	ErrInvalidSimulations       = "simulations must be between 0 and 100000"
+	ErrInvalidToleranceRate     = "tolerance must be between 0 and 0.5"
+	ErrInvalidProbability       = "probability must be between 0 and 1"
+	ErrMonteCarloNotSupported   = "Monte Carlo simulation is only supported by the cost approach"
+	ErrSensitivityNotSupported  = "sensitivity analysis is only supported by the cost approach"
+	ErrValuationIDRequired      = "valuation ID is required"
+	ErrAddressRequired          = "address is required"
+	ErrInvalidTimeRange         = "end time must be after start time"
+	ErrTimeBeforeEpoch          = "time must not be before 1970"
+	ErrInvalidPageSize          = "page size must be between 0 and 1000"
+	ErrInvalidPageToken         = "invalid page token"
+	ErrInvalidScenarioCount     = "between 1 and 50 scenarios are required"
+	ErrInvalidModificationCount = "a scenario must have between 1 and 20 modifications"
+	ErrInvalidModification      = "a modification must make exactly one change"
+	ErrInvalidModificationCost  = "modification cost must not be negative"
+	ErrUnknownFeature           = "unknown feature"
+	ErrFeatureAlreadyPresent    = "property already has this feature"
+	ErrFeatureNotPresent        = "property does not have this feature"
+	ErrInvalidValuationDate     = "valuation date must be between 1900 and ten years from now"
+	ErrBuiltAfterValuationDate  = "property was built after the valuation date"
+	ErrUnknownPriceIndexRegion  = "no price index is loaded for this region"
+	ErrValuationDateOutOfRange  = "valuation date is before the start of the price index"
+	ErrInvalidMonth             = "month must be formatted as YYYY-MM"
+	ErrInvalidTenantID          = "tenant ID must be 1 to 64 lowercase letters, digits, hyphens or underscores"
+	ErrInvalidPriceOverride     = "price per square foot must be positive"
+	ErrInvalidFeatureOverride   = "feature value must not be negative"
+	ErrInvalidLocationOverride  = "location multiplier must be positive"
+	ErrEmptyOverrideName        = "overridden names must not be empty"
+)
@@ -0,0 +1,249 @@
+package history
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	pb "github.com/jsarcade/property-valuation-service/proto"
+	bolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrNotFound is returned when no stored valuation matches a query
+var ErrNotFound = errors.New("valuation not found")
+
+// ErrInvalidPageToken is returned when a page token was not issued by List
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// Scope represents the valuations a caller may read: those made for its tenant,
+// or those of every tenant, and of callers of no tenant, when AllTenants is set
+type Scope struct {
+	Tenant     string // Empty for callers of no tenant
+	AllTenants bool
+}
+
+// visible reports whether a valuation may be read in the scope
+func (s Scope) visible(record *pb.ValuationRecord) bool {
+	return s.AllTenants || record.TenantId == s.Tenant
+}
+
+var (
+	valuationsBucket = []byte("valuations") // Record ID → encoded ValuationRecord
+	addressBucket    = []byte("by_address") // Address key → empty, ordered by address then time
+)
+
+// Store persists valuations in an embedded BoltDB file
+type Store struct {
+	db *bolt.DB
+}
+
+// Open opens the history store at path, creating it if it does not exist
+func Open(path string) (*Store, error) {
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open valuation history %s: %w", path, err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, bucket := range [][]byte{valuationsBucket, addressBucket} {
+			if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to initialise valuation history %s: %w", path, err)
+	}
+	return &Store{db: db}, nil
+}
+
+// Close closes the underlying database file
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// NormalizeAddress returns the form of an address used to match valuations of
+// the same property: lower case with whitespace collapsed
+func NormalizeAddress(address string) string {
+	return strings.ToLower(strings.Join(strings.Fields(address), " "))
+}
+
+// addressPrefix returns the index prefix shared by every valuation of an address
+func addressPrefix(address string) []byte {
+	return append([]byte(NormalizeAddress(address)), 0)
+}
+
+// addressKey returns the index key of a valuation; keys of one address sort by creation time
+func addressKey(address string, createdAt time.Time, id string) []byte {
+	key := addressPrefix(address)
+	key = binary.BigEndian.AppendUint64(key, uint64(createdAt.UnixNano()))
+	return append(key, id...)
+}
+
+// timeKey returns the smallest index key of an address at or after a point in time
+func timeKey(address string, t time.Time) []byte {
+	return binary.BigEndian.AppendUint64(addressPrefix(address), uint64(t.UnixNano()))
+}
+
+// Save stores a valuation, assigning it a time-ordered ID when it has none.
+// The record's created_at must be set.
+func (s *Store) Save(record *pb.ValuationRecord) error {
+	if record.Id == "" {
+		id, err := uuid.NewV7()
+		if err != nil {
+			return fmt.Errorf("failed to generate valuation ID: %w", err)
+		}
+		record.Id = id.String()
+	}
+
+	data, err := proto.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to encode valuation %s: %w", record.Id, err)
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(valuationsBucket).Put([]byte(record.Id), data); err != nil {
+			return err
+		}
+		return tx.Bucket(addressBucket).Put(addressKey(record.Address, record.CreatedAt.AsTime(), record.Id), nil)
+	})
+}
+
+// Get returns the valuation with the given ID visible in scope; valuations of
+// other tenants are not found
+func (s *Store) Get(scope Scope, id string) (*pb.ValuationRecord, error) {
+	var record *pb.ValuationRecord
+	err := s.db.View(func(tx *bolt.Tx) error {
+		var err error
+		record, err = get(tx, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !scope.visible(record) {
+		return nil, ErrNotFound
+	}
+	return record, nil
+}
+
+func get(tx *bolt.Tx, id string) (*pb.ValuationRecord, error) {
+	data := tx.Bucket(valuationsBucket).Get([]byte(id))
+	if data == nil {
+		return nil, ErrNotFound
+	}
+
+	record := &pb.ValuationRecord{}
+	if err := proto.Unmarshal(data, record); err != nil {
+		return nil, fmt.Errorf("failed to decode valuation %s: %w", id, err)
+	}
+	return record, nil
+}
+
+// List returns up to pageSize valuations of an address visible in scope and created
+// in [from, to), oldest first, and a token for the next page. Zero times leave the
+// range unbounded and the token is empty on the last page.
+func (s *Store) List(scope Scope, address string, from, to time.Time, pageSize int, pageToken string) ([]*pb.ValuationRecord, string, error) {
+	prefix := addressPrefix(address)
+	start := prefix
+	if !from.IsZero() {
+		start = timeKey(address, from)
+	}
+	var end []byte
+	if !to.IsZero() {
+		end = timeKey(address, to)
+	}
+
+	afterKey := []byte(nil)
+	if pageToken != "" {
+		key, err := base64.RawURLEncoding.DecodeString(pageToken)
+		if err != nil || !bytes.HasPrefix(key, prefix) {
+			return nil, "", ErrInvalidPageToken
+		}
+		afterKey = key
+	}
+
+	var records []*pb.ValuationRecord
+	var nextPageToken string
+	err := s.db.View(func(tx *bolt.Tx) error {
+		cursor := tx.Bucket(addressBucket).Cursor()
+
+		key, _ := cursor.Seek(start)
+		if afterKey != nil && bytes.Compare(afterKey, start) >= 0 {
+			key, _ = cursor.Seek(afterKey)
+			if bytes.Equal(key, afterKey) {
+				key, _ = cursor.Next()
+			}
+		}
+
+		for ; key != nil && bytes.HasPrefix(key, prefix); key, _ = cursor.Next() {
+			if end != nil && bytes.Compare(key, end) >= 0 {
+				break
+			}
+
+			record, err := get(tx, string(key[len(prefix)+8:]))
+			if err != nil {
+				return err
+			}
+			if !scope.visible(record) {
+				continue
+			}
+			if len(records) == pageSize {
+				nextPageToken = base64.RawURLEncoding.EncodeToString(lastKey(records))
+				break
+			}
+			records = append(records, record)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return records, nextPageToken, nil
+}
+
+// lastKey returns the index key of the last record of a page
+func lastKey(records []*pb.ValuationRecord) []byte {
+	last := records[len(records)-1]
+	return addressKey(last.Address, last.CreatedAt.AsTime(), last.Id)
+}
+
+// AsOf returns the latest valuation of an address visible in scope and created at
+// or before a point in time
+func (s *Store) AsOf(scope Scope, address string, at time.Time) (*pb.ValuationRecord, error) {
+	prefix := addressPrefix(address)
+
+	var record *pb.ValuationRecord
+	err := s.db.View(func(tx *bolt.Tx) error {
+		cursor := tx.Bucket(addressBucket).Cursor()
+
+		// Step back from the first key after the point in time
+		key, _ := cursor.Seek(timeKey(address, at.Add(time.Nanosecond)))
+		if key == nil {
+			key, _ = cursor.Last()
+		} else {
+			key, _ = cursor.Prev()
+		}
+		for ; key != nil && bytes.HasPrefix(key, prefix); key, _ = cursor.Prev() {
+			var err error
+			record, err = get(tx, string(key[len(prefix)+8:]))
+			if err != nil {
+				return err
+			}
+			if scope.visible(record) {
+				return nil
+			}
+		}
+		record = nil
+		return ErrNotFound
+	})
+	return record, err
+}
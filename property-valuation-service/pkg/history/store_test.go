@@ -0,0 +1,223 @@
+package history
+
+import (
+	"errors"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	pb "github.com/jsarcade/property-valuation-service/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// openTestStore opens a store in a temporary directory that is removed after the test
+func openTestStore(t *testing.T) *Store {
+	store, err := Open(filepath.Join(t.TempDir(), "history.db"))
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	t.Cleanup(func() { store.Close() })
+	return store
+}
+
+// saveValuation stores a valuation of an address made at a point in time
+func saveValuation(t *testing.T, store *Store, address string, createdAt time.Time, value float64) *pb.ValuationRecord {
+	record := &pb.ValuationRecord{
+		Address:      address,
+		CreatedAt:    timestamppb.New(createdAt),
+		ModelVersion: "test",
+		Result:       &pb.ValuationResult{Value: value},
+	}
+	if err := store.Save(record); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	return record
+}
+
+func TestGet(t *testing.T) {
+	store := openTestStore(t)
+	saved := saveValuation(t, store, "12 Elm St", time.Now(), 450000)
+	if saved.Id == "" {
+		t.Fatalf("Save did not assign an ID")
+	}
+
+	record, err := store.Get(Scope{}, saved.Id)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if record.Result.GetValue() != 450000 || record.ModelVersion != "test" {
+		t.Errorf("Get returned %v, want the saved valuation", record)
+	}
+
+	if _, err := store.Get(Scope{}, "missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get(missing) error = %v, want %v", err, ErrNotFound)
+	}
+}
+
+func TestListAndAsOf(t *testing.T) {
+	store := openTestStore(t)
+	march := time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+	saveValuation(t, store, "12 Elm St", march.AddDate(0, -2, 0), 400000)
+	saveValuation(t, store, "12 elm  st", march, 420000)
+	saveValuation(t, store, "12 Elm St", march.AddDate(0, 3, 0), 450000)
+	saveValuation(t, store, "14 Elm St", march, 999999)
+
+	t.Run("List", func(t *testing.T) {
+		tests := []struct {
+			name       string
+			from, to   time.Time
+			wantValues []float64
+		}{
+			{"Unbounded", time.Time{}, time.Time{}, []float64{400000, 420000, 450000}},
+			{"From", march, time.Time{}, []float64{420000, 450000}},
+			{"To is exclusive", time.Time{}, march, []float64{400000}},
+			{"Empty range", march.AddDate(1, 0, 0), time.Time{}, nil},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				records, next, err := store.List(Scope{}, "12 ELM ST", tt.from, tt.to, 10, "")
+				if err != nil {
+					t.Fatalf("List failed: %v", err)
+				}
+				if next != "" {
+					t.Errorf("Next page token = %q, want empty", next)
+				}
+				if len(records) != len(tt.wantValues) {
+					t.Fatalf("Got %d valuations, want %d", len(records), len(tt.wantValues))
+				}
+				for i, record := range records {
+					if record.Result.GetValue() != tt.wantValues[i] {
+						t.Errorf("Valuation %d = %v, want %v", i, record.Result.GetValue(), tt.wantValues[i])
+					}
+				}
+			})
+		}
+	})
+
+	t.Run("Pagination", func(t *testing.T) {
+		var values []float64
+		token := ""
+		for page := 0; page < 5; page++ {
+			records, next, err := store.List(Scope{}, "12 Elm St", time.Time{}, time.Time{}, 2, token)
+			if err != nil {
+				t.Fatalf("List failed: %v", err)
+			}
+			for _, record := range records {
+				values = append(values, record.Result.GetValue())
+			}
+			if next == "" {
+				break
+			}
+			token = next
+		}
+		if len(values) != 3 || values[0] != 400000 || values[2] != 450000 {
+			t.Errorf("Paged values = %v, want all three valuations in order", values)
+		}
+
+		if _, _, err := store.List(Scope{}, "14 Elm St", time.Time{}, time.Time{}, 2, token); !errors.Is(err, ErrInvalidPageToken) {
+			t.Errorf("List with another address's token error = %v, want %v", err, ErrInvalidPageToken)
+		}
+	})
+
+	t.Run("AsOf", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			at        time.Time
+			wantValue float64
+			wantErr   error
+		}{
+			{"Exact time", march, 420000, nil},
+			{"Between valuations", march.AddDate(0, 1, 0), 420000, nil},
+			{"After the latest", march.AddDate(5, 0, 0), 450000, nil},
+			{"Before the first", march.AddDate(-1, 0, 0), 0, ErrNotFound},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				record, err := store.AsOf(Scope{}, "12 Elm St", tt.at)
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("AsOf error = %v, want %v", err, tt.wantErr)
+				}
+				if err == nil && record.Result.GetValue() != tt.wantValue {
+					t.Errorf("AsOf value = %v, want %v", record.Result.GetValue(), tt.wantValue)
+				}
+			})
+		}
+
+		if _, err := store.AsOf(Scope{}, "1 Unknown Rd", march); !errors.Is(err, ErrNotFound) {
+			t.Errorf("AsOf for unknown address error = %v, want %v", err, ErrNotFound)
+		}
+	})
+}
+
+func TestTenants(t *testing.T) {
+	store := openTestStore(t)
+	march := time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+	save := func(tenant string, createdAt time.Time, value float64) *pb.ValuationRecord {
+		record := &pb.ValuationRecord{
+			Address:   "12 Elm St",
+			CreatedAt: timestamppb.New(createdAt),
+			Result:    &pb.ValuationResult{Value: value},
+			TenantId:  tenant,
+		}
+		if err := store.Save(record); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+		return record
+	}
+	acme := save("acme", march, 400000)
+	save("globex", march.AddDate(0, 1, 0), 410000)
+	save("acme", march.AddDate(0, 2, 0), 420000)
+	save("", march.AddDate(0, 3, 0), 430000)
+
+	if _, err := store.Get(Scope{Tenant: "globex"}, acme.Id); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get of another tenant's valuation error = %v, want %v", err, ErrNotFound)
+	}
+	if record, err := store.Get(Scope{AllTenants: true}, acme.Id); err != nil || record.Id != acme.Id {
+		t.Errorf("Get of all tenants = %v, %v, want the valuation of acme", record, err)
+	}
+
+	tests := []struct {
+		name       string
+		scope      Scope
+		wantValues []float64
+		wantAsOf   float64
+	}{
+		{"acme", Scope{Tenant: "acme"}, []float64{400000, 420000}, 420000},
+		{"globex", Scope{Tenant: "globex"}, []float64{410000}, 410000},
+		{"no tenant", Scope{}, []float64{430000}, 430000},
+		{"all tenants", Scope{AllTenants: true}, []float64{400000, 410000, 420000, 430000}, 430000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Pages of one valuation skip those of other tenants
+			var values []float64
+			token := ""
+			for page := 0; page < 5; page++ {
+				records, next, err := store.List(tt.scope, "12 Elm St", time.Time{}, time.Time{}, 1, token)
+				if err != nil {
+					t.Fatalf("List failed: %v", err)
+				}
+				for _, record := range records {
+					values = append(values, record.Result.GetValue())
+				}
+				if next == "" {
+					break
+				}
+				token = next
+			}
+			if !slices.Equal(values, tt.wantValues) {
+				t.Errorf("Listed values = %v, want %v", values, tt.wantValues)
+			}
+
+			record, err := store.AsOf(tt.scope, "12 Elm St", march.AddDate(1, 0, 0))
+			if err != nil || record.Result.GetValue() != tt.wantAsOf {
+				t.Errorf("AsOf = %v, %v, want the valuation of %v", record, err, tt.wantAsOf)
+			}
+		})
+	}
+
+	if _, err := store.AsOf(Scope{Tenant: "globex"}, "12 Elm St", march); !errors.Is(err, ErrNotFound) {
+		t.Errorf("AsOf before the first valuation of globex error = %v, want %v", err, ErrNotFound)
+	}
+}
@@ -0,0 +1,79 @@
+package income
+
+import (
+	"math"
+	"testing"
+)
+
+func testData() Data {
+	return Data{
+		RentRoll: []Lease{
+			{Tenant: "Acme Corp", SquareFootage: 4000, AnnualRent: 100000},
+			{Tenant: "Globex", SquareFootage: 8000, AnnualRent: 200000},
+		},
+		OtherIncome:       10000,
+		VacancyRate:       0.05,
+		OperatingExpenses: 80000,
+		CapRate:           0.07,
+	}
+}
+
+func TestValueDirectCapitalization(t *testing.T) {
+	result := Value(testData())
+
+	want := map[string][2]float64{
+		"potential gross income": {result.PotentialGrossIncome, 310000},
+		"vacancy loss":           {result.VacancyLoss, 15500},
+		"effective gross income": {result.EffectiveGrossIncome, 294500},
+		"net operating income":   {result.NetOperatingIncome, 214500},
+		"value":                  {result.Value, 214500 / 0.07},
+	}
+	for name, got := range want {
+		if math.Abs(got[0]-got[1]) > 0.01 {
+			t.Errorf("%s = %.2f, want %.2f", name, got[0], got[1])
+		}
+	}
+	if result.DCF != nil {
+		t.Error("Expected no DCF analysis when none was requested")
+	}
+}
+
+func TestValueDiscountedCashFlow(t *testing.T) {
+	// With no growth, no selling costs and a discount rate equal to the cap rate,
+	// the DCF value must equal the direct capitalization value
+	data := testData()
+	data.DCF = &DCFOptions{HoldingPeriodYears: 10, DiscountRate: data.CapRate}
+
+	result := Value(data)
+	if result.DCF == nil || len(result.DCF.CashFlows) != 10 {
+		t.Fatalf("Expected a 10 year DCF, got %+v", result.DCF)
+	}
+	if math.Abs(result.DCF.Value-result.DirectCapitalizationValue) > 0.01 {
+		t.Errorf("DCF value = %.2f, want %.2f", result.DCF.Value, result.DirectCapitalizationValue)
+	}
+	if result.Confidence != 0.95 {
+		t.Errorf("Confidence = %.2f, want 0.95 when both methods agree", result.Confidence)
+	}
+
+	// Rent growth above expense growth makes the DCF exceed direct capitalization
+	data.DCF.RentGrowthRate = 0.03
+	data.DCF.SellingCostRate = 0.02
+	if grown := Value(data); grown.DCF.Value <= grown.DirectCapitalizationValue {
+		t.Errorf("DCF value with rent growth = %.2f, want above %.2f", grown.DCF.Value, grown.DirectCapitalizationValue)
+	}
+}
+
+func TestIsIncomeProperty(t *testing.T) {
+	for propertyType, want := range map[string]bool{
+		"office_class_a":     true,
+		"retail_high_street": true,
+		"warehouse":          true,
+		"logistics":          true,
+		"house":              false,
+		"condo":              false,
+	} {
+		if got := IsIncomeProperty(propertyType); got != want {
+			t.Errorf("IsIncomeProperty(%q) = %v, want %v", propertyType, got, want)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package location
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+
+	"github.com/jsarcade/property-valuation-service/pkg/valuation"
+)
+
+// Point represents a single polygon vertex as [latitude, longitude]
+type Point [2]float64
+
+// Zone represents a named market area and the location class it belongs to
+type Zone struct {
+	Name     string  `json:"name"`
+	Class    string  `json:"class"`    // Key into the pricing model's LocationMultiplier
+	Priority int     `json:"priority"` // Higher priority wins when zones overlap
+	Polygon  []Point `json:"polygon"`  // Outer ring, implicitly closed
+
+	minLat, maxLat, minLon, maxLon float64
+}
+
+// zoneFile represents the on-disk layout of a zone data file
+type zoneFile struct {
+	Zones []Zone `json:"zones"`
+}
+
+// Classifier assigns a market class to a coordinate using locally loaded zones
+type Classifier struct {
+	zones []Zone
+}
+
+// NewClassifier validates the zones and builds a classifier from them. Their
+// classes are checked against each pricing model by Validate.
+func NewClassifier(zones []Zone) (*Classifier, error) {
+	classifier := &Classifier{zones: make([]Zone, 0, len(zones))}
+	for i, zone := range zones {
+		if zone.Name == "" {
+			return nil, fmt.Errorf("zone %d: name is required", i)
+		}
+		if zone.Class == "" {
+			return nil, fmt.Errorf("zone %q: class is required", zone.Name)
+		}
+		if len(zone.Polygon) < 3 {
+			return nil, fmt.Errorf("zone %q: polygon needs at least 3 points, got %d", zone.Name, len(zone.Polygon))
+		}
+
+		zone.minLat, zone.minLon = zone.Polygon[0][0], zone.Polygon[0][1]
+		zone.maxLat, zone.maxLon = zone.minLat, zone.minLon
+		for _, p := range zone.Polygon[1:] {
+			zone.minLat = min(zone.minLat, p[0])
+			zone.maxLat = max(zone.maxLat, p[0])
+			zone.minLon = min(zone.minLon, p[1])
+			zone.maxLon = max(zone.maxLon, p[1])
+		}
+		classifier.zones = append(classifier.zones, zone)
+	}
+	return classifier, nil
+}
+
+// Validate checks that a pricing model has a location multiplier for the class of
+// every zone, so that no zone is silently left unclassified by the model
+func (c *Classifier) Validate(model *valuation.PricingModel) error {
+	for _, zone := range c.zones {
+		if _, exists := model.LocationMultiplier[zone.Class]; !exists {
+			return fmt.Errorf("zone %q: pricing model %s has no multiplier for location class %q", zone.Name, model.Version, zone.Class)
+		}
+	}
+	return nil
+}
+
+// LoadClassifier reads zone data from a JSON file and builds a classifier
+func LoadClassifier(path string) (*Classifier, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read zone file: %w", err)
+	}
+
+	var file zoneFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse zone file %s: %w", path, err)
+	}
+	return NewClassifier(file.Zones)
+}
+
+// Classify returns the market class of the zone containing the location.
+// When several zones contain the point, the highest priority zone wins and
+// ties go to the zone declared first.
+func (c *Classifier) Classify(loc valuation.Location) (string, bool) {
+	var match *Zone
+	for i := range c.zones {
+		zone := &c.zones[i]
+		if match != nil && zone.Priority <= match.Priority {
+			continue
+		}
+		if zone.contains(loc.Latitude, loc.Longitude) {
+			match = zone
+		}
+	}
+	if match == nil {
+		return "", false
+	}
+	return match.Class, true
+}
+
+// contains reports whether the point lies inside the zone polygon (ray casting)
+func (z *Zone) contains(lat, lon float64) bool {
+	if lat < z.minLat || lat > z.maxLat || lon < z.minLon || lon > z.maxLon {
+		return false
+	}
+
+	inside := false
+	for i, j := 0, len(z.Polygon)-1; i < len(z.Polygon); j, i = i, i+1 {
+		yi, xi := z.Polygon[i][0], z.Polygon[i][1]
+		yj, xj := z.Polygon[j][0], z.Polygon[j][1]
+		if (yi > lat) != (yj > lat) && lon < (xj-xi)*(lat-yi)/(yj-yi)+xi {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// earthRadiusKm is the mean radius of the Earth used for distance calculations
+const earthRadiusKm = 6371.0
+
+// DistanceKm returns the great-circle distance between two locations (haversine)
+func DistanceKm(a, b valuation.Location) float64 {
+	lat1, lat2 := a.Latitude*math.Pi/180, b.Latitude*math.Pi/180
+	dLat := lat2 - lat1
+	dLon := (b.Longitude - a.Longitude) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(h))
+}
@@ -0,0 +1,85 @@
+package location
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/jsarcade/property-valuation-service/pkg/valuation"
+)
+
+func TestClassify(t *testing.T) {
+	classifier, err := LoadClassifier(filepath.Join("..", "..", "data", "location_zones.json"))
+	if err != nil {
+		t.Fatalf("LoadClassifier failed: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		location  valuation.Location
+		wantClass string
+		wantOK    bool
+	}{
+		{"Beach wins over overlapping suburbs", valuation.Location{Latitude: 25.8000, Longitude: -80.1280}, "beach", true},
+		{"Downtown", valuation.Location{Latitude: 25.7750, Longitude: -80.2000}, "urban", true},
+		{"Suburbs", valuation.Location{Latitude: 25.6500, Longitude: -80.4000}, "suburban", true},
+		{"Mountain", valuation.Location{Latitude: 39.1900, Longitude: -106.8200}, "mountain", true},
+		{"Outside all zones", valuation.Location{Latitude: 40.7128, Longitude: -74.0060}, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			class, ok := classifier.Classify(tt.location)
+			if class != tt.wantClass || ok != tt.wantOK {
+				t.Errorf("Classify() = (%q, %v), want (%q, %v)", class, ok, tt.wantClass, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestNewClassifierRejectsInvalidZones(t *testing.T) {
+	square := []Point{{0, 0}, {0, 1}, {1, 1}, {1, 0}}
+
+	tests := map[string]Zone{
+		"missing name":     {Class: "urban", Polygon: square},
+		"missing class":    {Name: "Nowhere", Polygon: square},
+		"degenerate shape": {Name: "Line", Class: "urban", Polygon: square[:2]},
+	}
+
+	for name, zone := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := NewClassifier([]Zone{zone}); err == nil {
+				t.Error("Expected error for invalid zone, got nil")
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	square := []Point{{0, 0}, {0, 1}, {1, 1}, {1, 0}}
+	classifier, err := NewClassifier([]Zone{{Name: "Nowhere", Class: "lunar", Polygon: square}})
+	if err != nil {
+		t.Fatalf("NewClassifier failed: %v", err)
+	}
+
+	model := valuation.BuiltinModel()
+	if err := classifier.Validate(model); err == nil {
+		t.Errorf("Validate of a model without the lunar class succeeded")
+	}
+	model.LocationMultiplier = map[string]float64{"lunar": 2}
+	if err := classifier.Validate(model); err != nil {
+		t.Errorf("Validate of a model with the lunar class failed: %v", err)
+	}
+}
+
+func TestDistanceKm(t *testing.T) {
+	miami := valuation.Location{Latitude: 25.7617, Longitude: -80.1918}
+	newYork := valuation.Location{Latitude: 40.7128, Longitude: -74.0060}
+
+	if d := DistanceKm(miami, miami); d != 0 {
+		t.Errorf("DistanceKm to self = %.3f, want 0", d)
+	}
+	// Miami to New York is roughly 1,757 km
+	if d := DistanceKm(miami, newYork); d < 1740 || d > 1775 {
+		t.Errorf("DistanceKm(Miami, New York) = %.0f, want about 1757", d)
+	}
+}
@@ -0,0 +1,180 @@
+package logging
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"strings"
+	"sync/atomic"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+	pb "github.com/jsarcade/property-valuation-service/proto"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// RequestIDKey is the metadata key, and HTTP header, carrying the ID of a request
+const RequestIDKey = "x-request-id"
+
+// maxRequestIDLength bounds the length of request IDs accepted from callers
+const maxRequestIDLength = 128
+
+// healthMethodPrefix prefixes the methods of the health service, logged at debug level
+const healthMethodPrefix = "/grpc.health.v1.Health/"
+
+// New creates a logger writing JSON records at or above the given level. Records
+// logged with the context of a request carry its request ID.
+func New(w io.Writer, level slog.Level) *slog.Logger {
+	return slog.New(contextHandler{slog.NewJSONHandler(w, &slog.HandlerOptions{Level: level})})
+}
+
+// contextHandler adds the request ID of the context to every record
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h contextHandler) Handle(ctx context.Context, record slog.Record) error {
+	if id := RequestID(ctx); id != "" {
+		record.AddAttrs(slog.String("request_id", id))
+	}
+	return h.Handler.Handle(ctx, record)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{h.Handler.WithGroup(name)}
+}
+
+type requestIDKey struct{}
+
+// WithRequestID returns a copy of ctx carrying the request ID
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestID returns the ID of the request ctx belongs to, or "" outside of a request
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// incomingRequestID returns the request ID sent by the caller, generating one when
+// it is missing or not a short printable string
+func incomingRequestID(ctx context.Context) string {
+	if values := metadata.ValueFromIncomingContext(ctx, RequestIDKey); len(values) > 0 {
+		id := values[0]
+		if id != "" && len(id) <= maxRequestIDLength && strings.IndexFunc(id, func(r rune) bool { return !unicode.IsPrint(r) }) < 0 {
+			return id
+		}
+	}
+	return uuid.NewString()
+}
+
+// startRequest assigns the request its ID and returns it to the caller in the response headers
+func startRequest(ctx context.Context) context.Context {
+	id := incomingRequestID(ctx)
+	grpc.SetHeader(ctx, metadata.Pairs(RequestIDKey, id)) // Fails only outside of a gRPC server
+	return WithRequestID(ctx, id)
+}
+
+// requestAttrs returns the attributes describing the request of an RPC
+func requestAttrs(ctx context.Context, method string) []slog.Attr {
+	attrs := []slog.Attr{slog.String("method", method)}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		attrs = append(attrs, slog.String("peer", p.Addr.String()))
+	}
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.IsValid() {
+		attrs = append(attrs, slog.String("trace_id", spanContext.TraceID().String()))
+	}
+	return attrs
+}
+
+// messageAttrs returns the attributes describing a request message
+func messageAttrs(req any) []slog.Attr {
+	switch req := req.(type) {
+	case interface{ GetProperty() *pb.Property }:
+		if property := req.GetProperty(); property != nil {
+			return []slog.Attr{slog.String("property_type", property.GetPropertyType())}
+		}
+	case *pb.BatchValuationRequest:
+		return []slog.Attr{slog.Int("items", len(req.GetRequests()))}
+	}
+	return nil
+}
+
+// logRPC logs the outcome of an RPC; server errors are logged as errors, client
+// errors as warnings and health checks at debug level
+func logRPC(ctx context.Context, logger *slog.Logger, method string, start time.Time, err error, attrs []slog.Attr) {
+	code := status.Code(err)
+	level := slog.LevelInfo
+	switch {
+	case code == codes.Internal || code == codes.Unknown || code == codes.DataLoss || code == codes.Unavailable:
+		level = slog.LevelError
+	case err != nil:
+		level = slog.LevelWarn
+	case strings.HasPrefix(method, healthMethodPrefix):
+		level = slog.LevelDebug
+	}
+
+	attrs = append(requestAttrs(ctx, method), attrs...)
+	attrs = append(attrs,
+		slog.Float64("latency_ms", float64(time.Since(start).Microseconds())/1000),
+		slog.String("code", code.String()),
+	)
+	if err != nil {
+		attrs = append(attrs, slog.String("error", status.Convert(err).Message()))
+	}
+	logger.LogAttrs(ctx, level, "rpc finished", attrs...)
+}
+
+// UnaryServerInterceptor assigns every unary RPC a request ID and logs its method,
+// latency, status code and property type
+func UnaryServerInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		ctx = startRequest(ctx)
+		resp, err := handler(ctx, req)
+		logRPC(ctx, logger, info.FullMethod, start, err, messageAttrs(req))
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor assigns every streaming RPC a request ID and logs its
+// method, duration, status code and the number of messages received
+func StreamServerInterceptor(logger *slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		stream := &loggedStream{ServerStream: ss, ctx: startRequest(ss.Context())}
+		err := handler(srv, stream)
+		logRPC(stream.ctx, logger, info.FullMethod, start, err, []slog.Attr{slog.Int64("messages", stream.received.Load())})
+		return err
+	}
+}
+
+// loggedStream carries the request ID in its context and counts received messages
+type loggedStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	received atomic.Int64
+}
+
+func (s *loggedStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *loggedStream) RecvMsg(m any) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		s.received.Add(1)
+	}
+	return err
+}
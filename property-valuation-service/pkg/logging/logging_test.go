@@ -0,0 +1,93 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/google/uuid"
+	pb "github.com/jsarcade/property-valuation-service/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	req := &pb.ValuationRequest{Property: &pb.Property{PropertyType: "condo"}}
+	info := &grpc.UnaryServerInfo{FullMethod: "/valuation.ValuationService/CalculateValuation"}
+
+	tests := []struct {
+		name          string
+		requestID     string
+		err           error
+		wantGenerated bool
+		wantLevel     string
+		wantCode      string
+	}{
+		{"Caller request ID", "req-42", nil, false, "INFO", "OK"},
+		{"Missing request ID", "", nil, true, "INFO", "OK"},
+		{"Unprintable request ID", "req\n42", nil, true, "INFO", "OK"},
+		{"Client error", "req-43", status.Error(codes.InvalidArgument, "bad property"), false, "WARN", "InvalidArgument"},
+		{"Server error", "req-44", status.Error(codes.Internal, "boom"), false, "ERROR", "Internal"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			interceptor := UnaryServerInterceptor(New(&out, slog.LevelInfo))
+
+			ctx := context.Background()
+			if tt.requestID != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(RequestIDKey, tt.requestID))
+			}
+			var handled string
+			_, err := interceptor(ctx, req, info, func(ctx context.Context, req any) (any, error) {
+				handled = RequestID(ctx)
+				return nil, tt.err
+			})
+			if err != tt.err {
+				t.Errorf("Interceptor error = %v, want %v", err, tt.err)
+			}
+
+			if tt.wantGenerated {
+				if _, err := uuid.Parse(handled); err != nil {
+					t.Errorf("Request ID = %q, want a generated UUID", handled)
+				}
+			} else if handled != tt.requestID {
+				t.Errorf("Request ID = %q, want %q", handled, tt.requestID)
+			}
+
+			var record map[string]any
+			if err := json.Unmarshal(out.Bytes(), &record); err != nil {
+				t.Fatalf("Log is not a JSON record: %v\n%s", err, out.String())
+			}
+			want := map[string]any{
+				"level":         tt.wantLevel,
+				"request_id":    handled,
+				"method":        info.FullMethod,
+				"code":          tt.wantCode,
+				"property_type": "condo",
+			}
+			for key, value := range want {
+				if record[key] != value {
+					t.Errorf("Log %s = %v, want %v", key, record[key], value)
+				}
+			}
+			if _, ok := record["latency_ms"].(float64); !ok {
+				t.Errorf("Log has no latency_ms: %v", record)
+			}
+		})
+	}
+}
+
+func TestHealthChecksLoggedAtDebug(t *testing.T) {
+	var out bytes.Buffer
+	interceptor := UnaryServerInterceptor(New(&out, slog.LevelInfo))
+	info := &grpc.UnaryServerInfo{FullMethod: healthMethodPrefix + "Check"}
+	interceptor(context.Background(), nil, info, func(ctx context.Context, req any) (any, error) { return nil, nil })
+	if out.Len() != 0 {
+		t.Errorf("Health check logged at info level: %s", out.String())
+	}
+}
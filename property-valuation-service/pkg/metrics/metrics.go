@@ -0,0 +1,139 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/jsarcade/property-valuation-service/pkg/valuation"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+const namespace = "valuation"
+
+// Metrics records RPC and valuation metrics in its own registry
+type Metrics struct {
+	registry *prometheus.Registry
+
+	rpcDuration     *prometheus.HistogramVec
+	rpcErrors       *prometheus.CounterVec
+	valuations      *prometheus.CounterVec
+	confidence      *prometheus.HistogramVec
+	conditionIssues *prometheus.CounterVec
+}
+
+// New creates the metrics of a valuation server, together with the Go runtime and
+// process metrics and the version of the active pricing model
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		rpcDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "rpc_duration_seconds",
+			Help:      "Latency of RPCs by method and status code.",
+			Buckets:   []float64{0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+		}, []string{"method", "code"}),
+		rpcErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "rpc_errors_total",
+			Help:      "RPCs that failed, by method and status code.",
+		}, []string{"method", "code"}),
+		valuations: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "valuations_total",
+			Help:      "Successful valuations by property type and valuation method.",
+		}, []string{"property_type", "method"}),
+		confidence: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "confidence_score",
+			Help:      "Confidence scores of successful valuations by valuation method.",
+			Buckets:   prometheus.LinearBuckets(0.5, 0.05, 10),
+		}, []string{"method"}),
+		conditionIssues: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "condition_issues_total",
+			Help:      "Condition-validation issues found in valued properties, by category.",
+		}, []string{"category"}),
+	}
+
+	m.registry.MustRegister(
+		m.rpcDuration,
+		m.rpcErrors,
+		m.valuations,
+		m.confidence,
+		m.conditionIssues,
+		modelCollector{},
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+	return m
+}
+
+// Handler returns the HTTP handler exposing the metrics in the Prometheus format
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+// ObserveValuation records a successful valuation with the given method, its
+// confidence score and the categories of the condition-validation issues found
+func (m *Metrics) ObserveValuation(propertyType, method string, confidence float64, issueCategories []string) {
+	m.valuations.WithLabelValues(propertyType, method).Inc()
+	m.confidence.WithLabelValues(method).Observe(confidence)
+	for _, category := range issueCategories {
+		m.conditionIssues.WithLabelValues(category).Inc()
+	}
+}
+
+// observeRPC records the latency and outcome of an RPC
+func (m *Metrics) observeRPC(method string, start time.Time, err error) {
+	code := status.Code(err).String()
+	m.rpcDuration.WithLabelValues(method, code).Observe(time.Since(start).Seconds())
+	if err != nil {
+		m.rpcErrors.WithLabelValues(method, code).Inc()
+	}
+}
+
+// UnaryServerInterceptor records the latency and outcome of unary RPCs
+func (m *Metrics) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		m.observeRPC(info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor records the duration and outcome of streaming RPCs
+func (m *Metrics) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		m.observeRPC(info.FullMethod, start, err)
+		return err
+	}
+}
+
+// modelDesc describes the gauge carrying the version of the active pricing model
+var modelDesc = prometheus.NewDesc(
+	prometheus.BuildFQName(namespace, "", "pricing_model_info"),
+	"Version of the active pricing model; always 1.",
+	[]string{"version"}, nil,
+)
+
+// modelCollector reports the active pricing model at scrape time so that the
+// version label follows hot reloads
+type modelCollector struct{}
+
+func (modelCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- modelDesc
+}
+
+func (modelCollector) Collect(ch chan<- prometheus.Metric) {
+	if model := valuation.ActiveModel(); model != nil {
+		ch <- prometheus.MustNewConstMetric(modelDesc, prometheus.GaugeValue, 1, model.Version)
+	}
+}
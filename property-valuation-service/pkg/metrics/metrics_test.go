@@ -0,0 +1,74 @@
+package metrics
+
+import (
+	"context"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jsarcade/property-valuation-service/pkg/valuation"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestObserveValuation(t *testing.T) {
+	m := New()
+	m.ObserveValuation("house", "cost", 0.82, []string{"age", "feature", "feature"})
+	m.ObserveValuation("house", "cost", 0.91, nil)
+
+	if got := testutil.ToFloat64(m.valuations.WithLabelValues("house", "cost")); got != 2 {
+		t.Errorf("Valuations = %v, want 2", got)
+	}
+	if got := testutil.ToFloat64(m.conditionIssues.WithLabelValues("feature")); got != 2 {
+		t.Errorf("Feature issues = %v, want 2", got)
+	}
+	if got := testutil.CollectAndCount(m.confidence); got != 1 {
+		t.Errorf("Confidence series = %d, want 1", got)
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	m := New()
+	interceptor := m.UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/valuation.ValuationService/CalculateValuation"}
+
+	ok := func(ctx context.Context, req any) (any, error) { return "ok", nil }
+	invalid := func(ctx context.Context, req any) (any, error) {
+		return nil, status.Error(codes.InvalidArgument, "invalid property")
+	}
+	interceptor(context.Background(), nil, info, ok)
+	interceptor(context.Background(), nil, info, invalid)
+
+	if got := testutil.ToFloat64(m.rpcErrors.WithLabelValues(info.FullMethod, "InvalidArgument")); got != 1 {
+		t.Errorf("InvalidArgument errors = %v, want 1", got)
+	}
+	if got := testutil.CollectAndCount(m.rpcErrors); got != 1 {
+		t.Errorf("Error series = %d, want only InvalidArgument", got)
+	}
+	if got := testutil.CollectAndCount(m.rpcDuration); got != 2 {
+		t.Errorf("Latency series = %d, want OK and InvalidArgument", got)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	m := New()
+	m.ObserveValuation("condo", "reconciled", 0.75, []string{"maintenance"})
+
+	recorder := httptest.NewRecorder()
+	m.Handler().ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+	body, _ := io.ReadAll(recorder.Body)
+
+	for _, want := range []string{
+		`valuation_valuations_total{method="reconciled",property_type="condo"} 1`,
+		`valuation_condition_issues_total{category="maintenance"} 1`,
+		`valuation_pricing_model_info{version="` + valuation.ActiveModel().Version + `"} 1`,
+		"go_goroutines",
+	} {
+		if !strings.Contains(string(body), want) {
+			t.Errorf("Metrics do not contain %q", want)
+		}
+	}
+}
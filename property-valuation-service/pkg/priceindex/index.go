@@ -0,0 +1,234 @@
+package priceindex
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrUnknownRegion is returned when no index series is loaded for a region
+var ErrUnknownRegion = errors.New("no price index for region")
+
+// ErrDateOutOfRange is returned for dates before the first observation of a series
+var ErrDateOutOfRange = errors.New("date is before the start of the price index")
+
+// dateLayout is the layout of observation dates in index files
+const dateLayout = "2006-01-02"
+
+// Observation represents the level of a price index at a date
+type Observation struct {
+	Date  time.Time
+	Level float64
+}
+
+// Indices holds house-price index series by region
+type Indices struct {
+	series map[string][]Observation // Sorted by date
+}
+
+// Load reads index series from a CSV file, or from every .csv file of a directory.
+// Files have a "region,date,index" header followed by one observation per row.
+func Load(path string) (*Indices, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read price indices: %w", err)
+	}
+
+	files := []string{path}
+	if info.IsDir() {
+		files, err = filepath.Glob(filepath.Join(path, "*.csv"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list price indices in %s: %w", path, err)
+		}
+	}
+
+	indices := &Indices{series: make(map[string][]Observation)}
+	for _, file := range files {
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read price indices: %w", err)
+		}
+		err = indices.parse(f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse price indices %s: %w", file, err)
+		}
+	}
+
+	if len(indices.series) == 0 {
+		return nil, fmt.Errorf("no price index observations found in %s", path)
+	}
+	if err := indices.sortSeries(); err != nil {
+		return nil, err
+	}
+	return indices, nil
+}
+
+// Parse reads index series from CSV data
+func Parse(r io.Reader) (*Indices, error) {
+	indices := &Indices{series: make(map[string][]Observation)}
+	if err := indices.parse(r); err != nil {
+		return nil, err
+	}
+	if err := indices.sortSeries(); err != nil {
+		return nil, err
+	}
+	return indices, nil
+}
+
+// sortSeries orders each series by date, rejecting duplicate observations
+func (ix *Indices) sortSeries() error {
+	for region, observations := range ix.series {
+		sort.Slice(observations, func(i, j int) bool {
+			return observations[i].Date.Before(observations[j].Date)
+		})
+		for i := 1; i < len(observations); i++ {
+			if observations[i].Date.Equal(observations[i-1].Date) {
+				return fmt.Errorf("region %s: duplicate observation for %s", region, observations[i].Date.Format(dateLayout))
+			}
+		}
+	}
+	return nil
+}
+
+// parse adds the observations of a CSV file to the indices
+func (ix *Indices) parse(r io.Reader) error {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 3
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return fmt.Errorf("missing header: %w", err)
+	}
+	if strings.Join(header, ",") != "region,date,index" {
+		return fmt.Errorf("header must be region,date,index, got %s", strings.Join(header, ","))
+	}
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		line, _ := reader.FieldPos(0)
+
+		region := NormalizeRegion(record[0])
+		if region == "" {
+			return fmt.Errorf("line %d: region is required", line)
+		}
+		date, err := time.Parse(dateLayout, record[1])
+		if err != nil {
+			return fmt.Errorf("line %d: invalid date %q", line, record[1])
+		}
+		level, err := strconv.ParseFloat(record[2], 64)
+		if err != nil || level <= 0 {
+			return fmt.Errorf("line %d: index must be a positive number, got %q", line, record[2])
+		}
+		ix.series[region] = append(ix.series[region], Observation{Date: date, Level: level})
+	}
+}
+
+// NormalizeRegion returns the form of a region name used to look up its series
+func NormalizeRegion(region string) string {
+	return strings.ToLower(strings.TrimSpace(region))
+}
+
+// Regions returns the regions with a loaded series, sorted by name
+func (ix *Indices) Regions() []string {
+	regions := make([]string, 0, len(ix.series))
+	for region := range ix.series {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+	return regions
+}
+
+// HasRegion reports whether a series is loaded for a region
+func (ix *Indices) HasRegion(region string) bool {
+	_, exists := ix.series[NormalizeRegion(region)]
+	return exists
+}
+
+// LatestDate returns the date of the last observation of a region's series
+func (ix *Indices) LatestDate(region string) (time.Time, bool) {
+	observations, exists := ix.series[NormalizeRegion(region)]
+	if !exists || len(observations) == 0 {
+		return time.Time{}, false
+	}
+	return observations[len(observations)-1].Date, true
+}
+
+// Level returns the index level of a region at a date. Levels between observations
+// are interpolated linearly; levels after the last observation are extrapolated at
+// the annual growth rate of the last twelve months of the series.
+func (ix *Indices) Level(region string, date time.Time) (float64, error) {
+	observations, exists := ix.series[NormalizeRegion(region)]
+	if !exists || len(observations) == 0 {
+		return 0, fmt.Errorf("%w %q", ErrUnknownRegion, region)
+	}
+
+	first, last := observations[0], observations[len(observations)-1]
+	if date.Before(first.Date) {
+		return 0, fmt.Errorf("%w: %s starts on %s", ErrDateOutOfRange, region, first.Date.Format(dateLayout))
+	}
+	if !date.Before(last.Date) {
+		return last.Level * math.Pow(1+ix.annualGrowth(observations), yearsBetween(last.Date, date)), nil
+	}
+
+	// The first observation after the date exists since the date is before the last one
+	i := sort.Search(len(observations), func(i int) bool {
+		return observations[i].Date.After(date)
+	})
+	before, after := observations[i-1], observations[i]
+	fraction := date.Sub(before.Date).Seconds() / after.Date.Sub(before.Date).Seconds()
+	return before.Level + (after.Level-before.Level)*fraction, nil
+}
+
+// annualGrowth returns the growth of a series over its last twelve months, or over
+// its whole length when it is shorter
+func (ix *Indices) annualGrowth(observations []Observation) float64 {
+	last := observations[len(observations)-1]
+	yearAgo := last.Date.AddDate(-1, 0, 0)
+	start := observations[0]
+	for _, observation := range observations {
+		if observation.Date.After(yearAgo) {
+			break
+		}
+		start = observation
+	}
+
+	years := yearsBetween(start.Date, last.Date)
+	if years == 0 {
+		return 0
+	}
+	return math.Pow(last.Level/start.Level, 1/years) - 1
+}
+
+// yearsBetween returns the number of years between two dates
+func yearsBetween(from, to time.Time) float64 {
+	return to.Sub(from).Hours() / (24 * 365.25)
+}
+
+// Factor returns the ratio of a region's index level at one date to its level at another
+func (ix *Indices) Factor(region string, from, to time.Time) (float64, error) {
+	fromLevel, err := ix.Level(region, from)
+	if err != nil {
+		return 0, err
+	}
+	toLevel, err := ix.Level(region, to)
+	if err != nil {
+		return 0, err
+	}
+	return toLevel / fromLevel, nil
+}
@@ -0,0 +1,99 @@
+package priceindex
+
+import (
+	"errors"
+	"math"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{"Valid", "region,date,index\nMiami,2025-01-01,100\nmiami,2024-01-01,90\n", ""},
+		{"Bad header", "area,date,level\nmiami,2025-01-01,100\n", "header"},
+		{"Bad date", "region,date,index\nmiami,01/01/2025,100\n", "invalid date"},
+		{"Non-positive index", "region,date,index\nmiami,2025-01-01,0\n", "positive"},
+		{"Duplicate date", "region,date,index\nmiami,2025-01-01,100\nmiami,2025-01-01,101\n", "duplicate"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			indices, err := Parse(strings.NewReader(tt.data))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("Parse() error = %v, want error containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse failed: %v", err)
+			}
+			if latest, _ := indices.LatestDate("MIAMI"); !latest.Equal(date(2025, 1, 1)) {
+				t.Errorf("LatestDate = %v, want 2025-01-01", latest)
+			}
+		})
+	}
+}
+
+func TestLevel(t *testing.T) {
+	indices, err := Parse(strings.NewReader("region,date,index\n" +
+		"miami,2024-01-01,100\n" +
+		"miami,2024-07-01,105\n" +
+		"miami,2025-01-01,110\n"))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		date    time.Time
+		want    float64
+		wantErr error
+	}{
+		{"Observation", date(2024, 7, 1), 105, nil},
+		{"Interpolated", date(2024, 10, 1), 105 + 5*92.0/184.0, nil},
+		{"Extrapolated one year", date(2026, 1, 1), 121, nil},
+		{"Before the series", date(2023, 12, 1), 0, ErrDateOutOfRange},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			level, err := indices.Level("miami", tt.date)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Level() error = %v, want %v", err, tt.wantErr)
+			}
+			if math.Abs(level-tt.want) > 0.1 {
+				t.Errorf("Level() = %.3f, want %.3f", level, tt.want)
+			}
+		})
+	}
+
+	if _, err := indices.Factor("aspen", date(2024, 1, 1), date(2025, 1, 1)); !errors.Is(err, ErrUnknownRegion) {
+		t.Errorf("Factor() for unknown region error = %v, want %v", err, ErrUnknownRegion)
+	}
+	factor, err := indices.Factor("miami", date(2025, 1, 1), date(2024, 1, 1))
+	if err != nil || math.Abs(factor-100.0/110.0) > 1e-9 {
+		t.Errorf("Factor() = %v, %v; want %.4f", factor, err, 100.0/110.0)
+	}
+}
+
+func TestLoadSampleIndices(t *testing.T) {
+	indices, err := Load(filepath.Join("..", "..", "data", "price_indices.csv"))
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	for _, region := range []string{"national", "miami", "aspen"} {
+		if !indices.HasRegion(region) {
+			t.Errorf("Sample indices have no %s series", region)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package pricing
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/jsarcade/property-valuation-service/pkg/valuation"
+	"gopkg.in/yaml.v3"
+)
+
+// LoadFile reads a pricing model from a YAML or JSON file and validates it.
+// When the file does not declare a version, one is derived from its content.
+func LoadFile(path string) (*valuation.PricingModel, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read pricing model: %w", err)
+	}
+	return Parse(data, filepath.Ext(path))
+}
+
+// Parse decodes a pricing model encoded in the format given by the file extension
+// (".yaml", ".yml" or ".json") and validates it
+func Parse(data []byte, ext string) (*valuation.PricingModel, error) {
+	model := &valuation.PricingModel{}
+
+	switch strings.ToLower(ext) {
+	case ".yaml", ".yml":
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		if err := decoder.Decode(model); err != nil {
+			return nil, fmt.Errorf("failed to parse pricing model: %w", err)
+		}
+	case ".json":
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(model); err != nil {
+			return nil, fmt.Errorf("failed to parse pricing model: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported pricing model format %q", ext)
+	}
+
+	if model.Version == "" {
+		sum := sha256.Sum256(data)
+		model.Version = "sha256:" + hex.EncodeToString(sum[:])[:12]
+	}
+
+	if err := model.Validate(); err != nil {
+		return nil, err
+	}
+	return model, nil
+}
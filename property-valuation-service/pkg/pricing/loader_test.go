@@ -0,0 +1,135 @@
+package pricing
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jsarcade/property-valuation-service/pkg/valuation"
+)
+
+const minimalModel = `{
+  "version": "%s",
+  "basePricePerSquareFoot": {"apartment": 250, "house": %s},
+  "conditionCriteria": {"good": {"multiplier": 1.1, "minAge": 0, "maxAge": 30}},
+  "featureValue": {"garage": 20000},
+  "locationMultiplier": {"urban": 1.2}
+}`
+
+func modelJSON(version, housePrice string) []byte {
+	return []byte(fmt.Sprintf(minimalModel, version, housePrice))
+}
+
+func TestLoadSampleModel(t *testing.T) {
+	model, err := LoadFile(filepath.Join("..", "..", "data", "pricing_model.yaml"))
+	if err != nil {
+		t.Fatalf("LoadFile failed: %v", err)
+	}
+
+	builtin := valuation.BuiltinModel()
+	if len(model.BasePricePerSquareFoot) != len(builtin.BasePricePerSquareFoot) ||
+		len(model.ConditionCriteria) != len(builtin.ConditionCriteria) ||
+		len(model.FeatureValue) != len(builtin.FeatureValue) ||
+		len(model.LocationMultiplier) != len(builtin.LocationMultiplier) ||
+		len(model.ReconciliationWeights) != len(builtin.ReconciliationWeights) {
+		t.Errorf("Sample model tables do not match the built-in tables")
+	}
+	if model.PriceDate.IsZero() {
+		t.Errorf("Sample model has no price date")
+	}
+	for name, condition := range builtin.ConditionCriteria {
+		sample := model.ConditionCriteria[name]
+		if sample.MinAge != condition.MinAge || sample.MaxAge != condition.MaxAge {
+			t.Errorf("Sample %s age window = %d-%d, want %d-%d", name, sample.MinAge, sample.MaxAge, condition.MinAge, condition.MaxAge)
+		}
+	}
+
+	property := valuation.Property{PropertyType: "house", SquareFootage: 1000, Condition: "good", YearBuilt: 2020}
+	_, _, breakdown := model.CalculateValuationAsOf(property, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC))
+	if breakdown.ModelVersion != model.Version {
+		t.Errorf("Breakdown model version = %q, want %q", breakdown.ModelVersion, model.Version)
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		ext     string
+		wantErr bool
+	}{
+		{"Valid JSON", modelJSON("v1", "300"), ".json", false},
+		{"Negative price", modelJSON("v1", "-300"), ".json", true},
+		{"Unknown field", []byte(`{"version": "v1", "basePrice": {}}`), ".json", true},
+		{"Missing default condition", []byte("version: v1\nbasePricePerSquareFoot:\n  apartment: 250\n"), ".yaml", true},
+		{"Unsupported format", modelJSON("v1", "300"), ".toml", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.data, tt.ext)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	t.Run("Derived version", func(t *testing.T) {
+		model, err := Parse(modelJSON("", "300"), ".json")
+		if err != nil {
+			t.Fatalf("Parse failed: %v", err)
+		}
+		if !strings.HasPrefix(model.Version, "sha256:") {
+			t.Errorf("Version = %q, want content-derived sha256 version", model.Version)
+		}
+	})
+}
+
+func TestWatch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "pricing.json")
+	if err := os.WriteFile(path, modelJSON("v1", "300"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	reloads := make(chan *valuation.PricingModel, 10)
+	errs := make(chan error, 10)
+	go Watch(ctx, path, func(m *valuation.PricingModel) { reloads <- m }, func(err error) { errs <- err })
+
+	// Give the watcher time to register before modifying the file
+	time.Sleep(100 * time.Millisecond)
+
+	if err := os.WriteFile(path, modelJSON("v1", "-1"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-errs:
+	case m := <-reloads:
+		t.Fatalf("Invalid model %s was reloaded", m.Version)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for reload error")
+	}
+
+	if err := os.WriteFile(path, modelJSON("v2", "320"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.After(5 * time.Second)
+	for {
+		select {
+		case m := <-reloads:
+			if m.Version == "v2" && m.BasePricePerSquareFoot["house"] == 320 {
+				return
+			}
+		case <-errs:
+			// Partial writes may be observed before the final content
+		case <-deadline:
+			t.Fatal("Timed out waiting for reload of v2")
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package pricing
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/jsarcade/property-valuation-service/pkg/valuation"
+)
+
+// Watch reloads the pricing model whenever the file changes and passes every
+// successfully validated model to onReload. Invalid files are reported to onError
+// and the previously loaded model stays in effect. Watch blocks until ctx is done.
+func Watch(ctx context.Context, path string, onReload func(*valuation.PricingModel), onError func(error)) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to create pricing model watcher: %w", err)
+	}
+	defer watcher.Close()
+
+	// Watch the directory rather than the file so that editors and config
+	// management tools that replace the file by renaming are picked up too
+	path = filepath.Clean(path)
+	if err := watcher.Add(filepath.Dir(path)); err != nil {
+		return fmt.Errorf("failed to watch pricing model: %w", err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if filepath.Clean(event.Name) != path || event.Op&(fsnotify.Write|fsnotify.Create) == 0 {
+				continue
+			}
+			model, err := LoadFile(path)
+			if err != nil {
+				onError(err)
+				continue
+			}
+			onReload(model)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			onError(err)
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package quota
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jsarcade/property-valuation-service/pkg/clock"
+	bolt "go.etcd.io/bbolt"
+)
+
+// ErrExceeded is returned when a client has used up its monthly quota
+var ErrExceeded = errors.New("monthly quota exceeded")
+
+// MonthFormat is the layout of the months usage is counted by, e.g. 2026-03
+const MonthFormat = "2006-01"
+
+// usageBucket holds a bucket per month mapping clients to their encoded Counter
+var usageBucket = []byte("usage")
+
+// Counter represents the usage of a client during a month
+type Counter struct {
+	Requests int64 // RPCs, or stream messages, that served at least one valuation
+	Units    int64 // Valuations served or reserved by requests in flight; a batch counts one per successful item
+}
+
+func (c Counter) encode() []byte {
+	data := binary.BigEndian.AppendUint64(nil, uint64(c.Requests))
+	return binary.BigEndian.AppendUint64(data, uint64(c.Units))
+}
+
+func decodeCounter(data []byte) (Counter, error) {
+	if len(data) == 0 {
+		return Counter{}, nil
+	}
+	if len(data) != 16 {
+		return Counter{}, fmt.Errorf("corrupt usage counter of %d bytes", len(data))
+	}
+	return Counter{
+		Requests: int64(binary.BigEndian.Uint64(data)),
+		Units:    int64(binary.BigEndian.Uint64(data[8:])),
+	}, nil
+}
+
+// Month returns the month usage at t is counted in
+func Month(t time.Time) string {
+	return t.UTC().Format(MonthFormat)
+}
+
+// NextMonth returns the start of the month following t, when quotas reset
+func NextMonth(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// Store persists the monthly usage of every client in an embedded BoltDB file
+type Store struct {
+	db    *bolt.DB
+	clock clock.Clock
+}
+
+// Open opens the usage store at path, creating it if it does not exist. Usage is
+// counted in the calendar month, in UTC, of the clock's current time.
+func Open(path string, clk clock.Clock) (*Store, error) {
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open quota store %s: %w", path, err)
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(usageBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to initialise quota store %s: %w", path, err)
+	}
+	return &Store{db: db, clock: clk}, nil
+}
+
+// Close closes the underlying database file
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// Reservation represents valuations counted against the quota of a client
+// before they are served
+type Reservation struct {
+	Client string
+	Month  string // Month the units are counted in, formatted as MonthFormat
+	Units  int64
+}
+
+// Reserve counts units valuations against the client's usage of the current month
+// before they are served, so that concurrent requests cannot together take the
+// client over limit. When limit is positive and the units would take the client's
+// usage over it, nothing is counted and ErrExceeded is returned along with the
+// current usage. Every reservation must be settled once the request is answered.
+func (s *Store) Reserve(client string, units, limit int64) (Reservation, Counter, error) {
+	r := Reservation{Client: client, Month: Month(s.clock.Now()), Units: units}
+	var usage Counter
+	exceeded := false
+	// Batch coalesces the writes of concurrent requests into one transaction
+	err := s.db.Batch(func(tx *bolt.Tx) error {
+		exceeded = false
+		bucket, err := tx.Bucket(usageBucket).CreateBucketIfNotExists([]byte(r.Month))
+		if err != nil {
+			return err
+		}
+		usage, err = decodeCounter(bucket.Get([]byte(client)))
+		if err != nil {
+			return err
+		}
+		if limit > 0 && usage.Units+units > limit {
+			exceeded = true
+			return nil
+		}
+		usage.Units += units
+		return bucket.Put([]byte(client), usage.encode())
+	})
+	if err != nil {
+		return Reservation{}, Counter{}, fmt.Errorf("failed to record usage of %s: %w", client, err)
+	}
+	if exceeded {
+		return Reservation{}, usage, ErrExceeded
+	}
+	return r, usage, nil
+}
+
+// Settle completes a reservation once its request is answered with served of the
+// reserved valuations: the others are returned to the client's usage of the month
+// they were reserved in, and the request is counted when it served any
+func (s *Store) Settle(r Reservation, served int64) error {
+	served = min(max(served, 0), r.Units)
+	if r.Units == 0 {
+		return nil
+	}
+	err := s.db.Batch(func(tx *bolt.Tx) error {
+		bucket, err := tx.Bucket(usageBucket).CreateBucketIfNotExists([]byte(r.Month))
+		if err != nil {
+			return err
+		}
+		usage, err := decodeCounter(bucket.Get([]byte(r.Client)))
+		if err != nil {
+			return err
+		}
+		if served > 0 {
+			usage.Requests++
+		}
+		usage.Units = max(usage.Units-(r.Units-served), 0)
+		return bucket.Put([]byte(r.Client), usage.encode())
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record usage of %s: %w", r.Client, err)
+	}
+	return nil
+}
+
+// Usage returns the usage of every client during a month, formatted as MonthFormat
+func (s *Store) Usage(month string) (map[string]Counter, error) {
+	usage := make(map[string]Counter)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(usageBucket).Bucket([]byte(month))
+		if bucket == nil {
+			return nil
+		}
+		return bucket.ForEach(func(client, data []byte) error {
+			counter, err := decodeCounter(data)
+			if err != nil {
+				return err
+			}
+			usage[string(client)] = counter
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read usage of %s: %w", month, err)
+	}
+	return usage, nil
+}
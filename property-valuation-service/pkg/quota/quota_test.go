@@ -0,0 +1,162 @@
+package quota
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jsarcade/property-valuation-service/pkg/clock"
+)
+
+func TestReserve(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "quota.db")
+	clk := clock.NewFake(time.Date(2026, 3, 31, 23, 0, 0, 0, time.UTC))
+	store, err := Open(path, clk)
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+
+	// consume reserves units and settles them as served
+	consume := func(client string, units, limit int64) error {
+		r, _, err := store.Reserve(client, units, limit)
+		if err != nil {
+			return err
+		}
+		return store.Settle(r, units)
+	}
+
+	if err := consume("api_key:crm", 1, 10); err != nil {
+		t.Fatalf("Reserve failed: %v", err)
+	}
+	_, usage, err := store.Reserve("api_key:crm", 8, 10)
+	if err != nil {
+		t.Fatalf("Reserve failed: %v", err)
+	}
+	// Reserved units count against the quota before the request is settled
+	if want := (Counter{Requests: 1, Units: 9}); usage != want {
+		t.Errorf("Usage = %+v, want %+v", usage, want)
+	}
+
+	// A request taking the client over its quota is rejected and not counted
+	_, usage, err = store.Reserve("api_key:crm", 2, 10)
+	if !errors.Is(err, ErrExceeded) {
+		t.Fatalf("Reserve error = %v, want %v", err, ErrExceeded)
+	}
+	if usage.Units != 9 {
+		t.Errorf("Units = %d after a rejected request, want 9", usage.Units)
+	}
+	// Other clients and unlimited requests are not affected
+	if err := consume("10.0.0.7", 5, 10); err != nil {
+		t.Errorf("Reserve of another client failed: %v", err)
+	}
+	if err := consume("api_key:crm", 100, 0); err != nil {
+		t.Errorf("Unlimited Reserve failed: %v", err)
+	}
+
+	// Usage survives restarts and quotas reset every month
+	if err := store.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	clk.Advance(2 * time.Hour)
+	store, err = Open(path, clk)
+	if err != nil {
+		t.Fatalf("Reopen failed: %v", err)
+	}
+	defer store.Close()
+	if err := consume("api_key:crm", 10, 10); err != nil {
+		t.Errorf("Reserve in a new month failed: %v", err)
+	}
+
+	tests := []struct {
+		month string
+		want  map[string]Counter
+	}{
+		{"2026-03", map[string]Counter{"api_key:crm": {Requests: 2, Units: 109}, "10.0.0.7": {Requests: 1, Units: 5}}},
+		{"2026-04", map[string]Counter{"api_key:crm": {Requests: 1, Units: 10}}},
+		{"2026-05", map[string]Counter{}},
+	}
+	for _, tt := range tests {
+		usage, err := store.Usage(tt.month)
+		if err != nil {
+			t.Fatalf("Usage(%s) failed: %v", tt.month, err)
+		}
+		if len(usage) != len(tt.want) {
+			t.Errorf("Usage(%s) = %+v, want %+v", tt.month, usage, tt.want)
+		}
+		for client, want := range tt.want {
+			if usage[client] != want {
+				t.Errorf("Usage(%s)[%s] = %+v, want %+v", tt.month, client, usage[client], want)
+			}
+		}
+	}
+}
+
+func TestSettle(t *testing.T) {
+	clk := clock.NewFake(time.Date(2026, 3, 31, 23, 0, 0, 0, time.UTC))
+	store, err := Open(filepath.Join(t.TempDir(), "quota.db"), clk)
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	defer store.Close()
+
+	reserve := func(units int64) Reservation {
+		t.Helper()
+		r, _, err := store.Reserve("api_key:crm", units, 10)
+		if err != nil {
+			t.Fatalf("Reserve failed: %v", err)
+		}
+		return r
+	}
+	batch, failed, late := reserve(4), reserve(3), reserve(2)
+
+	// Concurrent requests cannot together exceed the quota
+	if _, _, err := store.Reserve("api_key:crm", 2, 10); !errors.Is(err, ErrExceeded) {
+		t.Errorf("Reserve over the reservations in flight = %v, want %v", err, ErrExceeded)
+	}
+
+	// Units not served are returned, and requests serving nothing are not counted
+	if err := store.Settle(batch, 1); err != nil {
+		t.Fatalf("Settle failed: %v", err)
+	}
+	if err := store.Settle(failed, 0); err != nil {
+		t.Fatalf("Settle failed: %v", err)
+	}
+	// Reservations are settled in the month they were made in
+	clk.Advance(2 * time.Hour)
+	if err := store.Settle(late, 2); err != nil {
+		t.Fatalf("Settle failed: %v", err)
+	}
+	// Zero reservations, made when no quota is counted, are ignored
+	if err := store.Settle(Reservation{}, 1); err != nil {
+		t.Errorf("Settle of a zero reservation failed: %v", err)
+	}
+
+	usage, err := store.Usage("2026-03")
+	if err != nil {
+		t.Fatalf("Usage failed: %v", err)
+	}
+	if want := (Counter{Requests: 2, Units: 3}); usage["api_key:crm"] != want {
+		t.Errorf("Usage = %+v, want %+v", usage["api_key:crm"], want)
+	}
+	if usage, err := store.Usage("2026-04"); err != nil || len(usage) != 0 {
+		t.Errorf("Usage of the next month = %+v, %v, want none", usage, err)
+	}
+}
+
+func TestNextMonth(t *testing.T) {
+	tests := []struct {
+		t    time.Time
+		want time.Time
+	}{
+		{time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC), time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2026, 12, 31, 23, 59, 0, 0, time.UTC), time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)},
+		// Months are counted in UTC whatever the time zone of the clock
+		{time.Date(2026, 4, 1, 1, 0, 0, 0, time.FixedZone("CEST", 2*3600)), time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		if got := NextMonth(tt.t); !got.Equal(tt.want) {
+			t.Errorf("NextMonth(%v) = %v, want %v", tt.t, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,219 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jsarcade/property-valuation-service/pkg/quota"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// RetryAfterKey is the metadata key, and HTTP header, carrying the number of
+// seconds after which a rejected request may be retried
+const RetryAfterKey = "retry-after"
+
+// Options configures which requests the interceptors limit and how
+type Options struct {
+	Client func(ctx context.Context) string // Identifies the client a request is counted against
+	Cost   func(req any) int                // Valuations requested by a message; 1 when nil or not positive
+	Served func(resp any) int               // Valuations a response delivered; 1 when nil
+	Skip   []string                         // Prefixes of methods that are never limited, e.g. health checks
+	Quota  *quota.Store                     // Monthly usage counters; usage is not counted when nil
+}
+
+// limited reports whether the interceptors limit and count RPCs of method
+func limited(opts Options, method string) bool {
+	for _, prefix := range opts.Skip {
+		if strings.HasPrefix(method, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// admit takes the tokens a message of an RPC requires and reserves the valuations
+// it requests against the quota of the client, returning a RESOURCE_EXHAUSTED error
+// when the client has too few of either. The reservation must be settled once the
+// message is answered.
+func admit(ctx context.Context, l *Limiter, opts Options, client, method string, req any) (quota.Reservation, error) {
+	name := path.Base(method)
+	cost := 1
+	if opts.Cost != nil {
+		cost = max(opts.Cost(req), 1)
+	}
+
+	if ok, wait := l.Take(client, name, cost); !ok {
+		if wait == 0 {
+			return quota.Reservation{}, status.Errorf(codes.ResourceExhausted, "%s of %d valuations exceeds the burst limit of %d; split the request", name, cost, l.config.limit(name).Burst)
+		}
+		return quota.Reservation{}, exhausted(ctx, wait, fmt.Sprintf("rate limit of %s exceeded, retry in %s", name, wait.Round(time.Millisecond)))
+	}
+	if opts.Quota == nil {
+		return quota.Reservation{}, nil
+	}
+
+	limit := l.config.MonthlyQuota
+	if l.config.Exempts(client) {
+		limit = 0
+	}
+	r, _, err := opts.Quota.Reserve(client, int64(cost), limit)
+	if err != nil {
+		// Requests that are not served keep their tokens
+		l.Refund(client, name, cost)
+	}
+	if errors.Is(err, quota.ErrExceeded) {
+		now := l.clock.Now()
+		return quota.Reservation{}, exhausted(ctx, quota.NextMonth(now).Sub(now), fmt.Sprintf("monthly quota of %d valuations exceeded", limit))
+	}
+	if err != nil {
+		// Requests that cannot be billed are not served
+		return quota.Reservation{}, status.Error(codes.Unavailable, err.Error())
+	}
+	return r, nil
+}
+
+// settle returns the reserved valuations a response did not deliver to the quota
+// of the client; resp is nil when the request failed
+func settle(opts Options, r quota.Reservation, resp any) {
+	if opts.Quota == nil {
+		return
+	}
+	served := 0
+	if resp != nil {
+		served = 1
+		if opts.Served != nil {
+			served = opts.Served(resp)
+		}
+	}
+	// A reservation that cannot be settled stays counted in full, so the client
+	// is billed at most the valuations it requested
+	opts.Quota.Settle(r, int64(served))
+}
+
+// exhausted returns a RESOURCE_EXHAUSTED error telling the client when to retry,
+// both as RetryInfo details and as retry-after metadata in whole seconds
+func exhausted(ctx context.Context, wait time.Duration, message string) error {
+	seconds := int64(max(math.Ceil(wait.Seconds()), 1))
+	md := metadata.Pairs(RetryAfterKey, strconv.FormatInt(seconds, 10))
+	// Streams that already sent their headers carry it in the trailers instead
+	if err := grpc.SetHeader(ctx, md); err != nil {
+		grpc.SetTrailer(ctx, md)
+	}
+
+	st := status.New(codes.ResourceExhausted, message)
+	if detailed, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(wait)}); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+// UnaryServerInterceptor rejects unary RPCs whose client has exhausted its rate
+// limit or monthly quota; a batch takes a token per valuation it requests. The
+// valuations requested are reserved against the quota while the RPC runs, and
+// only those of successful responses stay counted, a batch per successful item.
+func UnaryServerInterceptor(l *Limiter, opts Options) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !limited(opts, info.FullMethod) {
+			return handler(ctx, req)
+		}
+		r, err := admit(ctx, l, opts, opts.Client(ctx), info.FullMethod, req)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := handler(ctx, req)
+		if err != nil {
+			settle(opts, r, nil)
+			return nil, err
+		}
+		settle(opts, r, resp)
+		return resp, nil
+	}
+}
+
+// StreamServerInterceptor limits streaming RPCs message by message: receiving a
+// message fails with RESOURCE_EXHAUSTED once the client has exhausted its rate
+// limit or monthly quota, which ends the stream. Every message sent settles the
+// reservation of the oldest message received, and the reservations of messages
+// left unanswered are returned when the stream ends.
+func StreamServerInterceptor(l *Limiter, opts Options) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !limited(opts, info.FullMethod) {
+			return handler(srv, ss)
+		}
+		s := &limitedStream{
+			ServerStream: ss,
+			limiter:      l,
+			opts:         opts,
+			method:       info.FullMethod,
+			client:       opts.Client(ss.Context()),
+		}
+		defer s.settleAll()
+		return handler(srv, s)
+	}
+}
+
+// limitedStream admits every message received and settles its reservation with
+// a message sent. Handlers may receive and send from different goroutines.
+type limitedStream struct {
+	grpc.ServerStream
+	limiter *Limiter
+	opts    Options
+	method  string
+	client  string // Resolved once when the stream starts
+
+	mu      sync.Mutex
+	pending []quota.Reservation // Reservations of the messages not answered yet, oldest first
+}
+
+func (s *limitedStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	r, err := admit(s.Context(), s.limiter, s.opts, s.client, s.method, m)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	s.pending = append(s.pending, r)
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *limitedStream) SendMsg(m any) error {
+	err := s.ServerStream.SendMsg(m)
+	s.mu.Lock()
+	var r quota.Reservation
+	if len(s.pending) > 0 {
+		r = s.pending[0]
+		s.pending = s.pending[1:]
+	}
+	s.mu.Unlock()
+	if err != nil {
+		settle(s.opts, r, nil)
+		return err
+	}
+	settle(s.opts, r, m)
+	return nil
+}
+
+// settleAll returns the reservations of the messages left unanswered
+func (s *limitedStream) settleAll() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, r := range s.pending {
+		settle(s.opts, r, nil)
+	}
+	s.pending = nil
+}
@@ -0,0 +1,191 @@
+package ratelimit
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/jsarcade/property-valuation-service/pkg/clock"
+	"gopkg.in/yaml.v3"
+)
+
+// Limit represents a token bucket refilled at Rate tokens per second up to Burst
+// tokens. Every valuation requested takes a token.
+type Limit struct {
+	Rate  float64 `yaml:"rate"`  // Sustained valuations per second; unlimited when 0
+	Burst int     `yaml:"burst"` // Valuations a client may request at once; at least 1 when limited
+}
+
+// Unlimited reports whether the limit lets every request through
+func (l Limit) Unlimited() bool {
+	return l.Rate == 0
+}
+
+// Config represents the limits of a YAML rate limits file
+type Config struct {
+	Default      Limit            `yaml:"default"`      // Limit of RPCs missing from Methods
+	Methods      map[string]Limit `yaml:"methods"`      // Limits by RPC name, e.g. CalculateValuation
+	Exempt       []string         `yaml:"exempt"`       // Clients that are never limited, e.g. api_key:dashboard
+	MonthlyQuota int64            `yaml:"monthlyQuota"` // Valuations each client may be served per calendar month; unlimited when 0
+}
+
+// LoadConfig loads and validates a YAML rate limits file
+func LoadConfig(path string) (Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("reading rate limits: %w", err)
+	}
+	var cfg Config
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&cfg); err != nil {
+		return Config{}, fmt.Errorf("parsing rate limits %s: %w", path, err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return Config{}, fmt.Errorf("invalid rate limits %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// Validate checks that every limit can be satisfied
+func (c Config) Validate() error {
+	check := func(name string, l Limit) error {
+		if l.Rate < 0 || math.IsNaN(l.Rate) || math.IsInf(l.Rate, 0) {
+			return fmt.Errorf("%s: rate must be a non-negative number, got %v", name, l.Rate)
+		}
+		if !l.Unlimited() && l.Burst < 1 {
+			return fmt.Errorf("%s: burst must be at least 1, got %d", name, l.Burst)
+		}
+		return nil
+	}
+	if err := check("default", c.Default); err != nil {
+		return err
+	}
+	for name, l := range c.Methods {
+		if err := check(name, l); err != nil {
+			return err
+		}
+	}
+	if c.MonthlyQuota < 0 {
+		return fmt.Errorf("monthlyQuota must not be negative, got %d", c.MonthlyQuota)
+	}
+	return nil
+}
+
+// Exempts reports whether a client is exempt from limits and quotas
+func (c Config) Exempts(client string) bool {
+	return slices.Contains(c.Exempt, client)
+}
+
+// limit returns the limit of an RPC name
+func (c Config) limit(name string) Limit {
+	if l, exists := c.Methods[name]; exists {
+		return l
+	}
+	return c.Default
+}
+
+// bucket represents the tokens left to a client for an RPC
+type bucket struct {
+	tokens float64
+	last   time.Time // Time tokens was last updated
+}
+
+type bucketKey struct {
+	client string
+	name   string
+}
+
+// sweepInterval is how often buckets that have refilled completely are dropped
+const sweepInterval = time.Minute
+
+// Limiter keeps a token bucket per client and RPC
+type Limiter struct {
+	config Config
+	clock  clock.Clock
+
+	mu        sync.Mutex
+	buckets   map[bucketKey]*bucket
+	lastSweep time.Time
+}
+
+// NewLimiter creates a limiter enforcing cfg at the clock's time
+func NewLimiter(cfg Config, clk clock.Clock) *Limiter {
+	return &Limiter{config: cfg, clock: clk, buckets: make(map[bucketKey]*bucket), lastSweep: clk.Now()}
+}
+
+// Config returns the limits the limiter enforces
+func (l *Limiter) Config() Config {
+	return l.config
+}
+
+// Take takes cost tokens from the bucket of a client for an RPC. When the bucket
+// holds too few tokens, nothing is taken and Take returns false with the time
+// after which the request would be allowed, or 0 when the cost exceeds the burst
+// and the request can never be allowed.
+func (l *Limiter) Take(client, name string, cost int) (bool, time.Duration) {
+	limit := l.config.limit(name)
+	if limit.Unlimited() || l.config.Exempts(client) {
+		return true, 0
+	}
+	if cost > limit.Burst {
+		return false, 0
+	}
+
+	now := l.clock.Now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.sweep(now)
+
+	key := bucketKey{client: client, name: name}
+	b, exists := l.buckets[key]
+	if !exists {
+		b = &bucket{tokens: float64(limit.Burst), last: now}
+		l.buckets[key] = b
+	}
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens = math.Min(float64(limit.Burst), b.tokens+elapsed*limit.Rate)
+		b.last = now
+	}
+
+	if b.tokens < float64(cost) {
+		missing := float64(cost) - b.tokens
+		return false, time.Duration(math.Ceil(missing / limit.Rate * float64(time.Second)))
+	}
+	b.tokens -= float64(cost)
+	return true, 0
+}
+
+// Refund returns cost tokens taken by Take to the bucket of a client for an RPC,
+// e.g. when the request is rejected for another reason after taking them
+func (l *Limiter) Refund(client, name string, cost int) {
+	limit := l.config.limit(name)
+	if limit.Unlimited() || l.config.Exempts(client) {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if b, exists := l.buckets[bucketKey{client: client, name: name}]; exists {
+		b.tokens = math.Min(float64(limit.Burst), b.tokens+float64(cost))
+	}
+}
+
+// sweep drops the buckets that have refilled completely, which behave as new
+// buckets, so that clients seen once do not hold memory forever
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < sweepInterval {
+		return
+	}
+	l.lastSweep = now
+	for key, b := range l.buckets {
+		limit := l.config.limit(key.name)
+		if b.tokens+now.Sub(b.last).Seconds()*limit.Rate >= float64(limit.Burst) {
+			delete(l.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package ratelimit
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jsarcade/property-valuation-service/pkg/clock"
+	"github.com/jsarcade/property-valuation-service/pkg/quota"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var testNow = time.Date(2026, 3, 31, 23, 0, 0, 0, time.UTC)
+
+func TestTake(t *testing.T) {
+	clk := clock.NewFake(testNow)
+	l := NewLimiter(Config{
+		Default: Limit{Rate: 2, Burst: 4},
+		Methods: map[string]Limit{"ListFeatures": {}},
+		Exempt:  []string{"api_key:dashboard"},
+	}, clk)
+
+	// The burst is available at once, then tokens refill at the rate
+	if ok, _ := l.Take("api_key:crm", "CalculateValuation", 3); !ok {
+		t.Fatalf("Take of 3 tokens from a full bucket failed")
+	}
+	ok, wait := l.Take("api_key:crm", "CalculateValuation", 2)
+	if ok || wait != 500*time.Millisecond {
+		t.Errorf("Take = %v, %v with 1 token left, want false, 500ms", ok, wait)
+	}
+	clk.Advance(500 * time.Millisecond)
+	if ok, _ := l.Take("api_key:crm", "CalculateValuation", 2); !ok {
+		t.Errorf("Take after the retry delay failed")
+	}
+
+	tests := []struct {
+		name     string
+		client   string
+		method   string
+		cost     int
+		wantOK   bool
+		wantWait time.Duration
+	}{
+		{"Bucket of another RPC", "api_key:crm", "SimulateScenarios", 4, true, 0},
+		{"Bucket of another client", "10.0.0.7", "CalculateValuation", 4, true, 0},
+		{"Empty bucket", "api_key:crm", "CalculateValuation", 1, false, 500 * time.Millisecond},
+		{"Cost over the burst", "10.0.0.8", "BatchCalculateValuation", 5, false, 0},
+		{"Unlimited RPC", "api_key:crm", "ListFeatures", 1000, true, 0},
+		{"Exempt client", "api_key:dashboard", "CalculateValuation", 1000, true, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, wait := l.Take(tt.client, tt.method, tt.cost)
+			if ok != tt.wantOK || wait != tt.wantWait {
+				t.Errorf("Take = %v, %v, want %v, %v", ok, wait, tt.wantOK, tt.wantWait)
+			}
+		})
+	}
+
+	// Buckets that have refilled are dropped and behave as new ones
+	clk.Advance(time.Hour)
+	if ok, _ := l.Take("api_key:crm", "CalculateValuation", 4); !ok {
+		t.Errorf("Take of the burst after an hour failed")
+	}
+	if len(l.buckets) != 1 {
+		t.Errorf("Limiter holds %d buckets, want 1", len(l.buckets))
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr bool
+	}{
+		{"Valid", "default: {rate: 5, burst: 10}\nmethods:\n  CalculateValuation: {rate: 1, burst: 2}\nexempt: [api_key:dashboard]\nmonthlyQuota: 1000\n", false},
+		{"Unlimited by default", "methods:\n  CalculateValuation: {rate: 1, burst: 1}\n", false},
+		{"Unknown field", "default: {rate: 5, burst: 10, period: 1s}\n", true},
+		{"Negative rate", "default: {rate: -1, burst: 10}\n", true},
+		{"Rate without burst", "methods:\n  CalculateValuation: {rate: 1}\n", true},
+		{"Negative quota", "monthlyQuota: -1\n", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "rate_limits.yaml")
+			if err := os.WriteFile(path, []byte(tt.content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := LoadConfig(path); (err != nil) != tt.wantErr {
+				t.Errorf("LoadConfig error = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	clk := clock.NewFake(testNow)
+	store, err := quota.Open(filepath.Join(t.TempDir(), "quota.db"), clk)
+	if err != nil {
+		t.Fatalf("Failed to open quota store: %v", err)
+	}
+	defer store.Close()
+
+	l := NewLimiter(Config{Default: Limit{Rate: 1, Burst: 5}, MonthlyQuota: 8}, clk)
+	interceptor := UnaryServerInterceptor(l, Options{
+		Client: func(ctx context.Context) string { return "api_key:crm" },
+		Cost:   func(req any) int { return req.(int) },
+		Served: func(resp any) int { return resp.(int) },
+		Skip:   []string{"/grpc.health.v1.Health/"},
+		Quota:  store,
+	})
+	// serve returns a handler serving the given number of valuations, or failing when negative
+	serve := func(served int) grpc.UnaryHandler {
+		return func(ctx context.Context, req any) (any, error) {
+			if served < 0 {
+				return nil, status.Error(codes.Internal, "valuation failed")
+			}
+			return served, nil
+		}
+	}
+	callServing := func(method string, cost, served int) error {
+		_, err := interceptor(context.Background(), cost, &grpc.UnaryServerInfo{FullMethod: method}, serve(served))
+		return err
+	}
+	call := func(method string, cost int) error {
+		return callServing(method, cost, cost)
+	}
+	retryDelay := func(err error) time.Duration {
+		for _, detail := range status.Convert(err).Details() {
+			if info, ok := detail.(*errdetails.RetryInfo); ok {
+				return info.RetryDelay.AsDuration()
+			}
+		}
+		return 0
+	}
+
+	if err := call("/valuation.ValuationService/BatchCalculateValuation", 5); err != nil {
+		t.Fatalf("Batch within the burst failed: %v", err)
+	}
+	err = call("/valuation.ValuationService/BatchCalculateValuation", 2)
+	if status.Code(err) != codes.ResourceExhausted || retryDelay(err) != 2*time.Second {
+		t.Errorf("Batch over the rate = %v (retry in %v), want ResourceExhausted retrying in 2s", err, retryDelay(err))
+	}
+	if err := call("/grpc.health.v1.Health/Check", 100); err != nil {
+		t.Errorf("Skipped method failed: %v", err)
+	}
+
+	// The quota counts the valuations served, not those requested, and resets next month
+	clk.Advance(5 * time.Second)
+	if err := callServing("/valuation.ValuationService/BatchCalculateValuation", 3, 1); err != nil {
+		t.Fatalf("Batch within the quota failed: %v", err)
+	}
+	if err := callServing("/valuation.ValuationService/CalculateValuation", 1, -1); status.Code(err) != codes.Internal {
+		t.Fatalf("Failed request = %v, want the error of the handler", err)
+	}
+	// Valuations in flight are reserved, so concurrent requests cannot exceed the quota
+	clk.Advance(5 * time.Second)
+	_, err = interceptor(context.Background(), 2, &grpc.UnaryServerInfo{FullMethod: "/valuation.ValuationService/BatchCalculateValuation"},
+		func(ctx context.Context, req any) (any, error) {
+			if err := call("/valuation.ValuationService/BatchCalculateValuation", 1); status.Code(err) != codes.ResourceExhausted {
+				t.Errorf("Request over the reserved quota = %v, want ResourceExhausted", err)
+			}
+			return 2, nil
+		})
+	if err != nil {
+		t.Fatalf("Batch within the quota failed: %v", err)
+	}
+	err = call("/valuation.ValuationService/BatchCalculateValuation", 1)
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("Request over the quota = %v, want ResourceExhausted", err)
+	}
+	if want := quota.NextMonth(clk.Now()).Sub(clk.Now()); retryDelay(err) != want {
+		t.Errorf("Retry delay = %v, want %v until next month", retryDelay(err), want)
+	}
+	usage, err := store.Usage("2026-03")
+	if err != nil {
+		t.Fatalf("Usage failed: %v", err)
+	}
+	if want := (quota.Counter{Requests: 3, Units: 8}); usage["api_key:crm"] != want {
+		t.Errorf("Usage = %+v, want %+v", usage["api_key:crm"], want)
+	}
+
+	// Requests rejected by the quota keep their tokens
+	if ok, _ := l.Take("api_key:crm", "BatchCalculateValuation", 3); !ok {
+		t.Errorf("Tokens of a request rejected by the quota were not refunded")
+	}
+}
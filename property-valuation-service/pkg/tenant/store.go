@@ -0,0 +1,121 @@
+package tenant
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jsarcade/property-valuation-service/pkg/clock"
+	"github.com/jsarcade/property-valuation-service/pkg/valuation"
+	bolt "go.etcd.io/bbolt"
+)
+
+// pricingBucket maps tenant IDs to their JSON-encoded Pricing
+var pricingBucket = []byte("pricing")
+
+// derivedModel represents a tenant's model and the base model it was derived from
+type derivedModel struct {
+	base  *valuation.PricingModel
+	model *valuation.PricingModel
+}
+
+// Store persists the pricing overrides of every tenant in an embedded BoltDB file
+// and keeps them in memory, so that valuations never read the file
+type Store struct {
+	db    *bolt.DB
+	clock clock.Clock
+
+	mu      sync.Mutex
+	pricing map[string]Pricing
+	models  map[string]derivedModel // Cached until the base model or the overrides change
+}
+
+// Open opens the tenant pricing store at path, creating it if it does not exist,
+// and loads the overrides of every tenant
+func Open(path string, clk clock.Clock) (*Store, error) {
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open tenant pricing %s: %w", path, err)
+	}
+
+	pricing := make(map[string]Pricing)
+	err = db.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(pricingBucket)
+		if err != nil {
+			return err
+		}
+		return bucket.ForEach(func(key, data []byte) error {
+			var p Pricing
+			if err := json.Unmarshal(data, &p); err != nil {
+				return fmt.Errorf("failed to decode pricing of tenant %s: %w", key, err)
+			}
+			pricing[string(key)] = p
+			return nil
+		})
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to load tenant pricing %s: %w", path, err)
+	}
+	return &Store{db: db, clock: clk, pricing: pricing, models: make(map[string]derivedModel)}, nil
+}
+
+// Close closes the underlying database file
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// Get returns the pricing of a tenant and whether it has ever been set
+func (s *Store) Get(tenant string) (Pricing, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, exists := s.pricing[tenant]
+	return p, exists
+}
+
+// Put replaces the overrides of a tenant, creating its pricing if needed, and
+// returns the pricing stored. Valuations started earlier keep the previous model.
+func (s *Store) Put(tenant string, overrides Overrides) (Pricing, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := Pricing{
+		Tenant:    tenant,
+		Overrides: overrides,
+		Revision:  s.pricing[tenant].Revision + 1,
+		UpdatedAt: s.clock.Now().UTC(),
+	}
+	data, err := json.Marshal(p)
+	if err != nil {
+		return Pricing{}, fmt.Errorf("failed to encode pricing of tenant %s: %w", tenant, err)
+	}
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(pricingBucket).Put([]byte(tenant), data)
+	})
+	if err != nil {
+		return Pricing{}, fmt.Errorf("failed to store pricing of tenant %s: %w", tenant, err)
+	}
+
+	s.pricing[tenant] = p
+	delete(s.models, tenant)
+	return p, nil
+}
+
+// Model returns the pricing model of a tenant: the base model with the tenant's
+// overrides layered on it, or the base model itself for tenants without overrides
+func (s *Store) Model(base *valuation.PricingModel, tenant string) *valuation.PricingModel {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, exists := s.pricing[tenant]
+	if !exists || p.Overrides.Empty() {
+		return base
+	}
+	if cached, ok := s.models[tenant]; ok && cached.base == base {
+		return cached.model
+	}
+	model := p.Apply(base)
+	s.models[tenant] = derivedModel{base: base, model: model}
+	return model
+}
@@ -0,0 +1,77 @@
+package tenant
+
+import (
+	"maps"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/jsarcade/property-valuation-service/pkg/valuation"
+)
+
+// idPattern matches the IDs tenants may be identified by
+var idPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,63}$`)
+
+// ValidID reports whether id can identify a tenant: 1 to 64 lowercase letters,
+// digits, hyphens or underscores, starting with a letter or digit
+func ValidID(id string) bool {
+	return idPattern.MatchString(id)
+}
+
+// Overrides represents the prices a tenant sets in place of those of the base
+// pricing model. Entries missing from the base model add property types, features
+// or location classes for the tenant only.
+type Overrides struct {
+	BasePricePerSquareFoot map[string]float64 `json:"basePricePerSquareFoot,omitempty"` // By property type
+	FeatureValue           map[string]float64 `json:"featureValue,omitempty"`           // By feature
+	LocationMultiplier     map[string]float64 `json:"locationMultiplier,omitempty"`     // By location class
+}
+
+// Empty reports whether the overrides leave the base model unchanged
+func (o Overrides) Empty() bool {
+	return len(o.BasePricePerSquareFoot) == 0 && len(o.FeatureValue) == 0 && len(o.LocationMultiplier) == 0
+}
+
+// Pricing represents the overrides of a tenant and when they were last replaced
+type Pricing struct {
+	Tenant    string    `json:"tenant"`
+	Overrides Overrides `json:"overrides"`
+	Revision  int64     `json:"revision"` // Incremented every time the overrides are replaced
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// Version returns the version of the pricing model the overrides derive from a
+// base model, e.g. 2024-06+acme.3, or the base version when there are none
+func (p Pricing) Version(base *valuation.PricingModel) string {
+	if p.Overrides.Empty() {
+		return base.Version
+	}
+	return base.Version + "+" + p.Tenant + "." + strconv.FormatInt(p.Revision, 10)
+}
+
+// Apply returns a copy of the base model with the overrides layered on it. The
+// base model is not modified and tables without overrides are shared with it.
+func (p Pricing) Apply(base *valuation.PricingModel) *valuation.PricingModel {
+	if p.Overrides.Empty() {
+		return base
+	}
+	model := *base
+	model.Version = p.Version(base)
+	model.BasePricePerSquareFoot = layer(base.BasePricePerSquareFoot, p.Overrides.BasePricePerSquareFoot)
+	model.FeatureValue = layer(base.FeatureValue, p.Overrides.FeatureValue)
+	model.LocationMultiplier = layer(base.LocationMultiplier, p.Overrides.LocationMultiplier)
+	return &model
+}
+
+// layer returns the base table with the overrides replacing or adding entries
+func layer(base, overrides map[string]float64) map[string]float64 {
+	if len(overrides) == 0 {
+		return base
+	}
+	table := maps.Clone(base)
+	if table == nil {
+		table = make(map[string]float64, len(overrides))
+	}
+	maps.Copy(table, overrides)
+	return table
+}
@@ -0,0 +1,134 @@
+package tenant
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jsarcade/property-valuation-service/pkg/clock"
+	"github.com/jsarcade/property-valuation-service/pkg/valuation"
+)
+
+func TestValidID(t *testing.T) {
+	tests := []struct {
+		id   string
+		want bool
+	}{
+		{"acme", true},
+		{"acme-realty_2", true},
+		{"7oaks", true},
+		{"", false},
+		{"Acme", false},
+		{"-acme", false},
+		{"acme realty", false},
+		{"acme/../other", false},
+		{string(make([]byte, 65)), false},
+	}
+	for _, tt := range tests {
+		if got := ValidID(tt.id); got != tt.want {
+			t.Errorf("ValidID(%q) = %v, want %v", tt.id, got, tt.want)
+		}
+	}
+}
+
+func TestApply(t *testing.T) {
+	base := valuation.BuiltinModel()
+	housePrice := base.BasePricePerSquareFoot["house"]
+	pricing := Pricing{
+		Tenant: "acme",
+		Overrides: Overrides{
+			BasePricePerSquareFoot: map[string]float64{"house": 420, "barn": 80},
+			FeatureValue:           map[string]float64{"garage": 35000},
+		},
+		Revision: 3,
+	}
+
+	model := pricing.Apply(base)
+	if model.Version != "builtin+acme.3" {
+		t.Errorf("Version = %q, want builtin+acme.3", model.Version)
+	}
+	if model.BasePricePerSquareFoot["house"] != 420 || model.BasePricePerSquareFoot["barn"] != 80 {
+		t.Errorf("Tenant prices = %v, want the overrides", model.BasePricePerSquareFoot)
+	}
+	if model.BasePricePerSquareFoot["apartment"] != base.BasePricePerSquareFoot["apartment"] {
+		t.Errorf("Apartment price = %v, want the base price", model.BasePricePerSquareFoot["apartment"])
+	}
+	if model.FeatureValue["garage"] != 35000 {
+		t.Errorf("Garage value = %v, want 35000", model.FeatureValue["garage"])
+	}
+	if err := model.Validate(); err != nil {
+		t.Errorf("Tenant model is invalid: %v", err)
+	}
+
+	// The base model is shared by every tenant and must not change
+	if base.BasePricePerSquareFoot["house"] != housePrice {
+		t.Errorf("Base house price = %v after Apply, want %v", base.BasePricePerSquareFoot["house"], housePrice)
+	}
+	if _, exists := base.BasePricePerSquareFoot["barn"]; exists {
+		t.Errorf("Tenant property type was added to the base model")
+	}
+
+	if got := (Pricing{Tenant: "acme", Revision: 4}).Apply(base); got != base {
+		t.Errorf("Apply without overrides = %s, want the base model", got.Version)
+	}
+}
+
+func TestStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tenants.db")
+	clk := clock.NewFake(time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC))
+	store, err := Open(path, clk)
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+
+	base := valuation.BuiltinModel()
+	if model := store.Model(base, "acme"); model != base {
+		t.Errorf("Model of a tenant without pricing = %s, want the base model", model.Version)
+	}
+
+	if _, err := store.Put("acme", Overrides{BasePricePerSquareFoot: map[string]float64{"house": 400}}); err != nil {
+		t.Fatalf("Put failed: %v", err)
+	}
+	first := store.Model(base, "acme")
+	if first.BasePricePerSquareFoot["house"] != 400 || first.Version != "builtin+acme.1" {
+		t.Errorf("Model = %s with house at %v, want builtin+acme.1 with house at 400", first.Version, first.BasePricePerSquareFoot["house"])
+	}
+	if store.Model(base, "acme") != first {
+		t.Errorf("Model was derived again although neither the base nor the overrides changed")
+	}
+	if store.Model(base, "other") != base {
+		t.Errorf("Overrides of acme apply to another tenant")
+	}
+
+	// Replacing the overrides or the base model derives a new model
+	clk.Advance(time.Hour)
+	pricing, err := store.Put("acme", Overrides{BasePricePerSquareFoot: map[string]float64{"house": 410}})
+	if err != nil {
+		t.Fatalf("Put failed: %v", err)
+	}
+	if pricing.Revision != 2 || !pricing.UpdatedAt.Equal(clk.Now()) {
+		t.Errorf("Pricing = revision %d updated at %v, want revision 2 updated at %v", pricing.Revision, pricing.UpdatedAt, clk.Now())
+	}
+	if got := store.Model(base, "acme").BasePricePerSquareFoot["house"]; got != 410 {
+		t.Errorf("House price = %v after Put, want 410", got)
+	}
+	reloaded := valuation.BuiltinModel()
+	reloaded.Version = "v2"
+	if got := store.Model(reloaded, "acme").Version; got != "v2+acme.2" {
+		t.Errorf("Version = %q after a base model reload, want v2+acme.2", got)
+	}
+
+	// Overrides survive restarts
+	if err := store.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	store, err = Open(path, clk)
+	if err != nil {
+		t.Fatalf("Reopen failed: %v", err)
+	}
+	defer store.Close()
+	got, exists := store.Get("acme")
+	if !exists || got.Revision != 2 || got.Overrides.BasePricePerSquareFoot["house"] != 410 {
+		t.Errorf("Get after reopen = %+v, %v, want revision 2 with house at 410", got, exists)
+	}
+}
@@ -1,9 +1,8 @@
 package testutil
 
 import (
-	"context"
 	"time"
-	"topreal/property-valuation-service/pkg/valuation"
+	"github.com/jsarcade/property-valuation-service/pkg/valuation"
 )
 
 // CreateTestProperty creates a valid test property
@@ -0,0 +1,168 @@
+package tlsconfig
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync/atomic"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// kubernetesDataLink is the symlink Kubernetes swaps atomically when it updates a
+// mounted secret; the certificate files themselves are symlinks that never change
+const kubernetesDataLink = "..data"
+
+// Options configures the server side of TLS connections
+type Options struct {
+	CertFile     string // PEM certificate chain served to clients
+	KeyFile      string // PEM private key of the certificate
+	ClientCAFile string // PEM bundle of the CAs client certificates must chain to; clients are not verified when empty
+}
+
+// credentials represents the certificate and client CAs loaded from the files
+type credentials struct {
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool // nil when clients are not verified
+}
+
+// Reloader serves the certificate and client CAs of its files, which can be
+// reloaded without restarting the listeners using them
+type Reloader struct {
+	opts    Options
+	current atomic.Pointer[credentials]
+}
+
+// NewReloader loads the certificate, key and client CAs of opts
+func NewReloader(opts Options) (*Reloader, error) {
+	if opts.CertFile == "" || opts.KeyFile == "" {
+		return nil, fmt.Errorf("a certificate and a key are required")
+	}
+	r := &Reloader{opts: opts}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reload reloads the files. On error the previously loaded certificate and client
+// CAs stay in effect.
+func (r *Reloader) Reload() error {
+	cert, err := tls.LoadX509KeyPair(r.opts.CertFile, r.opts.KeyFile)
+	if err != nil {
+		return fmt.Errorf("loading TLS certificate: %w", err)
+	}
+	loaded := &credentials{cert: &cert}
+
+	if r.opts.ClientCAFile != "" {
+		data, err := os.ReadFile(r.opts.ClientCAFile)
+		if err != nil {
+			return fmt.Errorf("reading client CA bundle: %w", err)
+		}
+		loaded.clientCAs = x509.NewCertPool()
+		if !loaded.clientCAs.AppendCertsFromPEM(data) {
+			return fmt.Errorf("client CA bundle %s contains no PEM certificates", r.opts.ClientCAFile)
+		}
+	}
+
+	r.current.Store(loaded)
+	return nil
+}
+
+// Certificate returns the certificate currently served
+func (r *Reloader) Certificate() *tls.Certificate {
+	return r.current.Load().cert
+}
+
+// MutualTLS reports whether clients must present a certificate signed by the client CAs
+func (r *Reloader) MutualTLS() bool {
+	return r.opts.ClientCAFile != ""
+}
+
+// Config returns a server TLS configuration that negotiates every connection with
+// the credentials loaded at the time of its handshake. When a client CA bundle is
+// configured, clients must present a certificate chaining to one of its CAs.
+func (r *Reloader) Config() *tls.Config {
+	base := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		NextProtos: []string{"h2", "http/1.1"},
+	}
+	if r.MutualTLS() {
+		base.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	config := base.Clone()
+	config.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		current := r.current.Load()
+		handshake := base.Clone()
+		handshake.Certificates = []tls.Certificate{*current.cert}
+		handshake.ClientCAs = current.clientCAs
+		return handshake, nil
+	}
+	// Serves callers that only consult the top-level configuration
+	config.GetCertificate = func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+		return r.Certificate(), nil
+	}
+	return config
+}
+
+// Watch reloads the files whenever one of them changes and calls onReload after
+// every successful reload. Failed reloads, e.g. while the certificate has been
+// replaced but not yet its key, are reported to onError and the previous credentials
+// stay in effect. Watch blocks until ctx is done.
+func (r *Reloader) Watch(ctx context.Context, onReload func(), onError func(error)) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to create TLS certificate watcher: %w", err)
+	}
+	defer watcher.Close()
+
+	// Watch the directories rather than the files so that files replaced by
+	// renaming, as done by certificate managers, are picked up too
+	var files, dirs []string
+	for _, path := range []string{r.opts.CertFile, r.opts.KeyFile, r.opts.ClientCAFile} {
+		if path == "" {
+			continue
+		}
+		path = filepath.Clean(path)
+		files = append(files, path)
+		if dir := filepath.Dir(path); !slices.Contains(dirs, dir) {
+			if err := watcher.Add(dir); err != nil {
+				return fmt.Errorf("failed to watch TLS certificate: %w", err)
+			}
+			dirs = append(dirs, dir)
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			name := filepath.Clean(event.Name)
+			if !slices.Contains(files, name) && filepath.Base(name) != kubernetesDataLink {
+				continue
+			}
+			if event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename) == 0 {
+				continue
+			}
+			if err := r.Reload(); err != nil {
+				onError(err)
+				continue
+			}
+			onReload()
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			onError(err)
+		}
+	}
+}
@@ -0,0 +1,191 @@
+package tlsconfig
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jsarcade/property-valuation-service/pkg/testutil"
+)
+
+// handshake connects a client with the given configuration to a listener serving
+// config, returning the certificate served and the server's handshake error
+func handshake(t *testing.T, config *tls.Config, client *tls.Config) (*x509.Certificate, error) {
+	t.Helper()
+	lis, err := tls.Listen("tcp", "127.0.0.1:0", config)
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	defer lis.Close()
+
+	serverErr := make(chan error, 1)
+	go func() {
+		conn, err := lis.Accept()
+		if err != nil {
+			serverErr <- err
+			return
+		}
+		defer conn.Close()
+		serverErr <- conn.(*tls.Conn).Handshake()
+	}()
+
+	conn, err := tls.Dial("tcp", lis.Addr().String(), client)
+	var served *x509.Certificate
+	if err == nil {
+		served = conn.ConnectionState().PeerCertificates[0]
+		conn.Close()
+	}
+	return served, <-serverErr
+}
+
+func TestConfig(t *testing.T) {
+	dir := t.TempDir()
+	ca := testutil.NewCA(t, "Test CA")
+	certFile, keyFile := ca.WriteIssued(t, dir, "server", true)
+	clientCert, clientKey := ca.Issue(t, "client", false)
+	trusted, err := tls.X509KeyPair(clientCert, clientKey)
+	if err != nil {
+		t.Fatalf("Failed to load client certificate: %v", err)
+	}
+	rogueCert, rogueKey := testutil.NewCA(t, "Rogue CA").Issue(t, "client", false)
+	rogue, err := tls.X509KeyPair(rogueCert, rogueKey)
+	if err != nil {
+		t.Fatalf("Failed to load client certificate: %v", err)
+	}
+
+	t.Run("TLS", func(t *testing.T) {
+		r, err := NewReloader(Options{CertFile: certFile, KeyFile: keyFile})
+		if err != nil {
+			t.Fatalf("NewReloader failed: %v", err)
+		}
+		served, err := handshake(t, r.Config(), &tls.Config{RootCAs: ca.Pool(), ServerName: "localhost"})
+		if err != nil {
+			t.Fatalf("Handshake failed: %v", err)
+		}
+		if served.Subject.CommonName != "server" {
+			t.Errorf("Served certificate = %q, want server", served.Subject.CommonName)
+		}
+	})
+
+	t.Run("Mutual TLS", func(t *testing.T) {
+		r, err := NewReloader(Options{CertFile: certFile, KeyFile: keyFile, ClientCAFile: ca.WriteCert(t, dir)})
+		if err != nil {
+			t.Fatalf("NewReloader failed: %v", err)
+		}
+		tests := []struct {
+			name    string
+			certs   []tls.Certificate
+			wantErr bool
+		}{
+			{"Trusted client certificate", []tls.Certificate{trusted}, false},
+			{"No client certificate", nil, true},
+			{"Client certificate of another CA", []tls.Certificate{rogue}, true},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := handshake(t, r.Config(), &tls.Config{RootCAs: ca.Pool(), ServerName: "localhost", Certificates: tt.certs})
+				if (err != nil) != tt.wantErr {
+					t.Errorf("Handshake error = %v, want error %v", err, tt.wantErr)
+				}
+			})
+		}
+	})
+}
+
+func TestNewReloaderErrors(t *testing.T) {
+	dir := t.TempDir()
+	ca := testutil.NewCA(t, "Test CA")
+	certFile, keyFile := ca.WriteIssued(t, dir, "server", true)
+	_, otherKey := ca.WriteIssued(t, dir, "other", true)
+	notPEM := filepath.Join(dir, "not.pem")
+	if err := os.WriteFile(notPEM, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		opts Options
+	}{
+		{"Missing key", Options{CertFile: certFile}},
+		{"Missing file", Options{CertFile: filepath.Join(dir, "missing.pem"), KeyFile: keyFile}},
+		{"Key of another certificate", Options{CertFile: certFile, KeyFile: otherKey}},
+		{"Empty client CA bundle", Options{CertFile: certFile, KeyFile: keyFile, ClientCAFile: notPEM}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewReloader(tt.opts); err == nil {
+				t.Errorf("NewReloader succeeded, want an error")
+			}
+		})
+	}
+}
+
+func TestWatch(t *testing.T) {
+	dir := t.TempDir()
+	ca := testutil.NewCA(t, "Test CA")
+	certFile, keyFile := ca.WriteIssued(t, dir, "server", true)
+	r, err := NewReloader(Options{CertFile: certFile, KeyFile: keyFile})
+	if err != nil {
+		t.Fatalf("NewReloader failed: %v", err)
+	}
+	config := r.Config()
+	client := &tls.Config{RootCAs: ca.Pool(), ServerName: "localhost"}
+	before, err := handshake(t, config, client)
+	if err != nil {
+		t.Fatalf("Handshake failed: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reloads := make(chan struct{}, 10)
+	errs := make(chan error, 10)
+	go r.Watch(ctx, func() { reloads <- struct{}{} }, func(err error) { errs <- err })
+
+	// Give the watcher time to register before modifying the files
+	time.Sleep(100 * time.Millisecond)
+
+	// A corrupt certificate is reported and the previous one stays in effect
+	if err := os.WriteFile(certFile, []byte("corrupt"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-errs:
+	case <-reloads:
+		t.Fatal("Corrupt certificate was reloaded")
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for reload error")
+	}
+	if served, err := handshake(t, config, client); err != nil || !served.Equal(before) {
+		t.Fatalf("Handshake after a failed reload served %v (%v), want the previous certificate", served, err)
+	}
+
+	// Renewing the certificate and key swaps them without restarting the listener
+	certPEM, keyPEM := ca.Issue(t, "server", true)
+	if err := os.WriteFile(keyFile, keyPEM, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(certFile, certPEM, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.After(5 * time.Second)
+	for {
+		select {
+		case <-reloads:
+			served, err := handshake(t, config, client)
+			if err != nil {
+				t.Fatalf("Handshake after reload failed: %v", err)
+			}
+			if !served.Equal(before) {
+				return
+			}
+		case <-errs:
+			// Partial writes may be observed before the final content
+		case <-deadline:
+			t.Fatal("Timed out waiting for the renewed certificate")
+		}
+	}
+}
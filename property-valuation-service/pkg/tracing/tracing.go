@@ -0,0 +1,102 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// ServiceName identifies the valuation service in exported traces
+const ServiceName = "property-valuation-service"
+
+// instrumentationName names the tracer creating the spans of the service
+const instrumentationName = "github.com/jsarcade/property-valuation-service"
+
+// Propagator reads and writes the W3C trace context and baggage carried by requests,
+// e.g. the traceparent header sent by the API gateway
+var Propagator = propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+
+// PropagationHeaders are the HTTP headers carrying the trace context
+var PropagationHeaders = Propagator.Fields()
+
+// Options configures where spans are exported
+type Options struct {
+	OTLPEndpoint string    // host:port of an OTLP/gRPC collector; spans are not exported over OTLP when empty
+	OTLPInsecure bool      // Connect to the collector without TLS
+	Stdout       io.Writer // Spans are also written as JSON when set, for local testing
+}
+
+// Enabled reports whether spans are exported anywhere
+func (o Options) Enabled() bool {
+	return o.OTLPEndpoint != "" || o.Stdout != nil
+}
+
+// NewProvider creates a tracer provider exporting spans as configured. Spans are
+// sampled when the caller sampled the trace, or always for traces started here.
+func NewProvider(ctx context.Context, opts Options) (*sdktrace.TracerProvider, error) {
+	res, err := resource.New(ctx,
+		resource.WithAttributes(semconv.ServiceName(ServiceName)),
+		resource.WithTelemetrySDK(),
+		resource.WithFromEnv(), // OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES take precedence
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating trace resource: %w", err)
+	}
+
+	options := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.AlwaysSample())),
+	}
+	if opts.OTLPEndpoint != "" {
+		clientOptions := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(opts.OTLPEndpoint)}
+		if opts.OTLPInsecure {
+			clientOptions = append(clientOptions, otlptracegrpc.WithInsecure())
+		}
+		exporter, err := otlptracegrpc.New(ctx, clientOptions...)
+		if err != nil {
+			return nil, fmt.Errorf("creating OTLP trace exporter: %w", err)
+		}
+		options = append(options, sdktrace.WithBatcher(exporter))
+	}
+	if opts.Stdout != nil {
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(opts.Stdout))
+		if err != nil {
+			return nil, fmt.Errorf("creating stdout trace exporter: %w", err)
+		}
+		// Written synchronously so that local runs show spans as soon as they end
+		options = append(options, sdktrace.WithSyncer(exporter))
+	}
+	return sdktrace.NewTracerProvider(options...), nil
+}
+
+// StartSpan starts a child span of the span in ctx using the same tracer provider.
+// Nothing is recorded when ctx carries no recording span, so code that runs many
+// valuations internally, e.g. Monte Carlo simulations, does not create new traces.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	parent := trace.SpanFromContext(ctx)
+	if !parent.IsRecording() {
+		return ctx, noop.Span{}
+	}
+	tracer := parent.TracerProvider().Tracer(instrumentationName)
+	return tracer.Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan records err on the span, if any, and ends it
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,65 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestStartSpan(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	// Without a recording parent nothing is recorded
+	ctx, span := StartSpan(context.Background(), "orphan")
+	if span.IsRecording() || ctx != context.Background() {
+		t.Errorf("StartSpan without a parent returned a recording span")
+	}
+	span.End()
+
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "parent")
+	_, child := StartSpan(ctx, "child", attribute.String("key", "value"))
+	EndSpan(child, context.Canceled)
+	parent.End()
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("Recorded %d spans, want 2", len(spans))
+	}
+	recorded := spans[0]
+	if recorded.Name() != "child" || recorded.Parent().SpanID() != parent.SpanContext().SpanID() {
+		t.Errorf("First span = %s with parent %s, want child of %s", recorded.Name(), recorded.Parent().SpanID(), parent.SpanContext().SpanID())
+	}
+	if recorded.Status().Description != context.Canceled.Error() || len(recorded.Events()) != 1 {
+		t.Errorf("Child span status = %+v with %d events, want the recorded error", recorded.Status(), len(recorded.Events()))
+	}
+}
+
+func TestNewProviderStdout(t *testing.T) {
+	var out bytes.Buffer
+	opts := Options{Stdout: &out}
+	if !opts.Enabled() || (Options{}).Enabled() {
+		t.Fatalf("Enabled() does not follow the configured exporters")
+	}
+
+	provider, err := NewProvider(context.Background(), opts)
+	if err != nil {
+		t.Fatalf("NewProvider failed: %v", err)
+	}
+	_, span := provider.Tracer("test").Start(context.Background(), "valuation.calculate")
+	span.End()
+	if err := provider.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown failed: %v", err)
+	}
+
+	for _, want := range []string{`"Name":"valuation.calculate"`, ServiceName} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("Exported spans do not contain %q:\n%s", want, out.String())
+		}
+	}
+}
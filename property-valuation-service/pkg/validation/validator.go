@@ -1,92 +1,332 @@
 package validation
 
 import (
+	"fmt"
+	"maps"
+	"math"
+	"slices"
 	"time"
-	"topreal/property-valuation-service/pkg/errors"
-	"topreal/property-valuation-service/pkg/valuation"
+	"github.com/jsarcade/property-valuation-service/pkg/errors"
+	"github.com/jsarcade/property-valuation-service/pkg/income"
+	"github.com/jsarcade/property-valuation-service/pkg/tenant"
+	"github.com/jsarcade/property-valuation-service/pkg/valuation"
 )
 
-// ValidateProperty validates a property's fields
-func ValidateProperty(property valuation.Property) error {
+// MaintenanceLevels lists the maintenance levels a property may report, best first
+var MaintenanceLevels = []string{"excellent", "very_good", "good", "fair", "poor", "very_poor"}
+
+// RenovationStatuses lists the renovation statuses a property may report, most recent first
+var RenovationStatuses = []string{"recent", "standard", "needs_updates", "needs_repairs", "needs_renovation"}
+
+// ValidateProperty validates a property's fields against the given pricing model at
+// the given current time, collecting every violation instead of stopping at the first one
+func ValidateProperty(model *valuation.PricingModel, property valuation.Property, now time.Time) error {
+	var violations errors.ValidationErrors
+	addViolation := func(field, message string) {
+		violations = append(violations, &errors.ValidationError{
+			Field:   field,
+			Message: message,
+		})
+	}
+
 	// Validate property type
-	if _, exists := valuation.BasePricePerSquareFoot[property.PropertyType]; !exists {
-		return &errors.ValidationError{
-			Field:   "property_type",
-			Message: errors.ErrInvalidPropertyType,
-		}
+	if _, exists := model.BasePricePerSquareFoot[property.PropertyType]; !exists {
+		addViolation("property_type", errors.ErrInvalidPropertyType)
 	}
 
 	// Validate condition
-	if _, exists := valuation.ConditionCriteria[property.Condition]; !exists {
-		return &errors.ValidationError{
-			Field:   "condition",
-			Message: errors.ErrInvalidCondition,
-		}
+	if _, exists := model.ConditionCriteria[property.Condition]; !exists {
+		addViolation("condition", errors.ErrInvalidCondition)
 	}
 
 	// Validate maintenance level
-	validMaintenanceLevels := map[string]bool{
-		"excellent":   true,
-		"very_good":   true,
-		"good":        true,
-		"fair":        true,
-		"poor":        true,
-		"very_poor":   true,
-	}
-	if !validMaintenanceLevels[property.MaintenanceLevel] {
-		return &errors.ValidationError{
-			Field:   "maintenance_level",
-			Message: errors.ErrInvalidMaintenanceLevel,
-		}
+	if !slices.Contains(MaintenanceLevels, property.MaintenanceLevel) {
+		addViolation("maintenance_level", errors.ErrInvalidMaintenanceLevel)
 	}
 
 	// Validate renovation status
-	validRenovationStatuses := map[string]bool{
-		"recent":           true,
-		"standard":         true,
-		"needs_updates":    true,
-		"needs_repairs":    true,
-		"needs_renovation": true,
-	}
-	if !validRenovationStatuses[property.RenovationStatus] {
-		return &errors.ValidationError{
-			Field:   "renovation_status",
-			Message: errors.ErrInvalidRenovationStatus,
-		}
+	if !slices.Contains(RenovationStatuses, property.RenovationStatus) {
+		addViolation("renovation_status", errors.ErrInvalidRenovationStatus)
 	}
 
 	// Validate year built
-	currentYear := time.Now().Year()
-	if property.YearBuilt < 1800 || property.YearBuilt > currentYear {
-		return &errors.ValidationError{
-			Field:   "year_built",
-			Message: errors.ErrInvalidYearBuilt,
-		}
+	if property.YearBuilt < 1800 || property.YearBuilt > now.Year() {
+		addViolation("year_built", errors.ErrInvalidYearBuilt)
 	}
 
 	// Validate square footage
 	if property.SquareFootage <= 0 || property.SquareFootage > 100000 {
-		return &errors.ValidationError{
-			Field:   "square_footage",
-			Message: errors.ErrInvalidSquareFootage,
+		addViolation("square_footage", errors.ErrInvalidSquareFootage)
+	}
+
+	// Validate bedrooms and bathrooms; commercial property types may have none
+	minRooms := 1
+	if income.IsIncomeProperty(property.PropertyType) {
+		minRooms = 0
+	}
+	if property.Bedrooms < minRooms || property.Bedrooms > 20 {
+		addViolation("bedrooms", errors.ErrInvalidBedrooms)
+	}
+	if property.Bathrooms < minRooms || property.Bathrooms > 20 {
+		addViolation("bathrooms", errors.ErrInvalidBathrooms)
+	}
+
+	// Validate location coordinates when provided; NaN fails every comparison
+	if math.IsNaN(property.Location.Latitude) || math.IsNaN(property.Location.Longitude) ||
+		property.Location.Latitude < -90 || property.Location.Latitude > 90 ||
+		property.Location.Longitude < -180 || property.Location.Longitude > 180 {
+		addViolation("location", errors.ErrInvalidLocation)
+	}
+
+	if len(violations) > 0 {
+		return violations
+	}
+	return nil
+}
+
+// ValidateIncome validates the income data of a property valued by the income
+// approach, collecting every violation instead of stopping at the first one
+func ValidateIncome(data income.Data) error {
+	var violations errors.ValidationErrors
+	addViolation := func(field, message string) {
+		violations = append(violations, &errors.ValidationError{
+			Field:   field,
+			Message: message,
+		})
+	}
+
+	// Validate rent roll
+	if len(data.RentRoll) == 0 {
+		addViolation("income.rent_roll", errors.ErrInvalidRentRoll)
+	}
+	for i, lease := range data.RentRoll {
+		if lease.AnnualRent < 0 {
+			addViolation(fmt.Sprintf("income.rent_roll[%d].annual_rent", i), errors.ErrInvalidRentRoll)
+		}
+	}
+	if data.OtherIncome < 0 {
+		addViolation("income.other_income", errors.ErrInvalidOtherIncome)
+	}
+
+	// Validate vacancy, expenses and cap rate
+	if data.VacancyRate < 0 || data.VacancyRate > 1 {
+		addViolation("income.vacancy_rate", errors.ErrInvalidVacancyRate)
+	}
+	if data.OperatingExpenses < 0 {
+		addViolation("income.operating_expenses", errors.ErrInvalidOperatingExpenses)
+	}
+	if data.CapRate <= 0 || data.CapRate >= 1 {
+		addViolation("income.cap_rate", errors.ErrInvalidCapRate)
+	}
+
+	// Validate discounted cash flow assumptions when requested
+	if dcf := data.DCF; dcf != nil {
+		if dcf.HoldingPeriodYears < 1 || dcf.HoldingPeriodYears > 30 {
+			addViolation("income.dcf.holding_period_years", errors.ErrInvalidHoldingPeriod)
+		}
+		if dcf.DiscountRate <= 0 || dcf.DiscountRate >= 1 {
+			addViolation("income.dcf.discount_rate", errors.ErrInvalidDiscountRate)
+		}
+		if dcf.RentGrowthRate < -0.5 || dcf.RentGrowthRate > 0.5 {
+			addViolation("income.dcf.rent_growth_rate", errors.ErrInvalidGrowthRate)
+		}
+		if dcf.ExpenseGrowthRate < -0.5 || dcf.ExpenseGrowthRate > 0.5 {
+			addViolation("income.dcf.expense_growth_rate", errors.ErrInvalidGrowthRate)
+		}
+		if dcf.TerminalCapRate < 0 || dcf.TerminalCapRate >= 1 {
+			addViolation("income.dcf.terminal_cap_rate", errors.ErrInvalidCapRate)
+		}
+		if dcf.SellingCostRate < 0 || dcf.SellingCostRate >= 1 {
+			addViolation("income.dcf.selling_cost_rate", errors.ErrInvalidSellingCostRate)
+		}
+	}
+
+	if len(violations) > 0 {
+		return violations
+	}
+	return nil
+}
+
+// ValidateIntervalOptions validates the options used to derive a value range,
+// collecting every violation instead of stopping at the first one
+func ValidateIntervalOptions(options valuation.IntervalOptions) error {
+	var violations errors.ValidationErrors
+	addViolation := func(field, message string) {
+		violations = append(violations, &errors.ValidationError{
+			Field:   field,
+			Message: message,
+		})
+	}
+
+	if options.ConfidenceLevel < 0 || options.ConfidenceLevel >= 1 {
+		addViolation("interval.confidence_level", errors.ErrInvalidConfidenceLevel)
+	}
+	if options.Simulations < 0 || options.Simulations > 100000 {
+		addViolation("interval.simulations", errors.ErrInvalidSimulations)
+	}
+	if options.SquareFootageTolerance < 0 || options.SquareFootageTolerance > 0.5 {
+		addViolation("interval.square_footage_tolerance", errors.ErrInvalidToleranceRate)
+	}
+	if options.ConditionUncertainty < 0 || options.ConditionUncertainty > 1 {
+		addViolation("interval.condition_uncertainty", errors.ErrInvalidProbability)
+	}
+
+	if len(violations) > 0 {
+		return violations
+	}
+	return nil
+}
+
+// ValidateScenarios validates what-if scenarios for a validated property against the
+// given pricing model. Each scenario's modified property must itself be valid at the
+// given current time; its violations are reported under the scenario's field path.
+func ValidateScenarios(model *valuation.PricingModel, property valuation.Property, scenarios []valuation.Scenario, now time.Time) error {
+	var violations errors.ValidationErrors
+	addViolation := func(field, message string) {
+		violations = append(violations, &errors.ValidationError{
+			Field:   field,
+			Message: message,
+		})
+	}
+
+	if len(scenarios) == 0 || len(scenarios) > 50 {
+		addViolation("scenarios", errors.ErrInvalidScenarioCount)
+	}
+
+	for i, scenario := range scenarios {
+		scenarioField := fmt.Sprintf("scenarios[%d]", i)
+		if len(scenario.Modifications) == 0 || len(scenario.Modifications) > 20 {
+			addViolation(scenarioField+".modifications", errors.ErrInvalidModificationCount)
+		}
+
+		modified := property
+		for j, modification := range scenario.Modifications {
+			field := fmt.Sprintf("%s.modifications[%d]", scenarioField, j)
+
+			if changeCount(modification) != 1 {
+				addViolation(field, errors.ErrInvalidModification)
+				continue
+			}
+			if modification.Cost < 0 {
+				addViolation(field+".cost", errors.ErrInvalidModificationCost)
+			}
+
+			if feature := modification.AddFeature; feature != "" {
+				if _, exists := model.FeatureValue[feature]; !exists {
+					addViolation(field+".add_feature", errors.ErrUnknownFeature)
+				} else if slices.Contains(modified.Features, feature) {
+					addViolation(field+".add_feature", errors.ErrFeatureAlreadyPresent)
+				}
+			}
+			if feature := modification.RemoveFeature; feature != "" && !slices.Contains(modified.Features, feature) {
+				addViolation(field+".remove_feature", errors.ErrFeatureNotPresent)
+			}
+
+			modified = modification.Apply(modified)
+		}
+
+		// The modified property must be as valid as the property it started from
+		if err := ValidateProperty(model, modified, now); err != nil {
+			for _, violation := range err.(errors.ValidationErrors) {
+				addViolation(scenarioField+".property."+violation.Field, violation.Message)
+			}
+		}
+	}
+
+	if len(violations) > 0 {
+		return violations
+	}
+	return nil
+}
+
+// changeCount returns the number of changes a modification makes
+func changeCount(m valuation.Modification) int {
+	count := 0
+	for _, changed := range []bool{
+		m.AddFeature != "",
+		m.RemoveFeature != "",
+		m.Condition != "",
+		m.MaintenanceLevel != "",
+		m.RenovationStatus != "",
+		m.AddSquareFootage != 0,
+	} {
+		if changed {
+			count++
 		}
 	}
+	return count
+}
+
+// ValidateValuationDate validates the date a property is valued at relative to the
+// given current time and, when price indices are loaded, that they cover the
+// property's region at that date
+func ValidateValuationDate(model *valuation.PricingModel, property valuation.Property, date, now time.Time) error {
+	var violations errors.ValidationErrors
+	addViolation := func(field, message string) {
+		violations = append(violations, &errors.ValidationError{
+			Field:   field,
+			Message: message,
+		})
+	}
 
-	// Validate bedrooms
-	if property.Bedrooms <= 0 || property.Bedrooms > 20 {
-		return &errors.ValidationError{
-			Field:   "bedrooms",
-			Message: errors.ErrInvalidBedrooms,
+	if date.Year() < 1900 || date.After(now.AddDate(10, 0, 0)) {
+		addViolation("valuation_date", errors.ErrInvalidValuationDate)
+	} else if property.YearBuilt > date.Year() {
+		addViolation("year_built", errors.ErrBuiltAfterValuationDate)
+	} else if valuation.PriceIndex != nil {
+		if property.Region != "" && !valuation.PriceIndex.HasRegion(property.Region) {
+			addViolation("region", errors.ErrUnknownPriceIndexRegion)
+		} else if _, _, err := model.PriceIndexFactor(property, date); err != nil {
+			addViolation("valuation_date", errors.ErrValuationDateOutOfRange)
 		}
 	}
 
-	// Validate bathrooms
-	if property.Bathrooms <= 0 || property.Bathrooms > 20 {
-		return &errors.ValidationError{
-			Field:   "bathrooms",
-			Message: errors.ErrInvalidBathrooms,
+	if len(violations) > 0 {
+		return violations
+	}
+	return nil
+}
+
+// ValidateTenantPricing validates the ID of a tenant and the pricing overrides set
+// for it, collecting every violation instead of stopping at the first one
+func ValidateTenantPricing(id string, overrides tenant.Overrides) error {
+	var violations errors.ValidationErrors
+	addViolation := func(field, message string) {
+		violations = append(violations, &errors.ValidationError{
+			Field:   field,
+			Message: message,
+		})
+	}
+
+	if !tenant.ValidID(id) {
+		addViolation("tenant_id", errors.ErrInvalidTenantID)
+	}
+
+	tables := []struct {
+		field   string
+		values  map[string]float64
+		valid   func(float64) bool
+		message string
+	}{
+		{"overrides.base_price_per_square_foot", overrides.BasePricePerSquareFoot, func(v float64) bool { return v > 0 }, errors.ErrInvalidPriceOverride},
+		{"overrides.feature_value", overrides.FeatureValue, func(v float64) bool { return v >= 0 }, errors.ErrInvalidFeatureOverride},
+		{"overrides.location_multiplier", overrides.LocationMultiplier, func(v float64) bool { return v > 0 }, errors.ErrInvalidLocationOverride},
+	}
+	for _, table := range tables {
+		for _, name := range slices.Sorted(maps.Keys(table.values)) {
+			field := fmt.Sprintf("%s[%s]", table.field, name)
+			value := table.values[name]
+			switch {
+			case name == "":
+				addViolation(field, errors.ErrEmptyOverrideName)
+			case math.IsInf(value, 0) || !table.valid(value):
+				addViolation(field, table.message)
+			}
 		}
 	}
 
+	if len(violations) > 0 {
+		return violations
+	}
 	return nil
-} 
\ No newline at end of file
+}
@@ -0,0 +1,101 @@
+package valuation
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Adjustment represents a single validation adjustment factor applied to the condition multiplier
+type Adjustment struct {
+	Category string  `json:"category"`
+	Factor   float64 `json:"factor"`
+}
+
+// FeatureAddition represents the value added by a single property feature
+type FeatureAddition struct {
+	Feature string  `json:"feature"`
+	Value   float64 `json:"value"`
+}
+
+// ValuationBreakdown represents each stage of a property valuation
+type ValuationBreakdown struct {
+	ModelVersion          string              `json:"modelVersion"` // Version of the pricing model used
+	PropertyType          string              `json:"propertyType"`
+	PricePerSquareFoot    float64             `json:"pricePerSquareFoot"`
+	ValuationDate         time.Time           `json:"valuationDate"`
+	PriceIndexRegion      string              `json:"priceIndexRegion"` // Empty when base prices were not indexed
+	PriceIndexFactor      float64             `json:"priceIndexFactor"` // Index level at the valuation date relative to the price date
+	BaseValue             float64             `json:"baseValue"`        // Square footage × price per sq ft
+	LocationClass         string              `json:"locationClass"`    // Empty when the location was not classified
+	LocationMultiplier    float64             `json:"locationMultiplier"`
+	ConditionDescription  string              `json:"conditionDescription"`
+	ConditionMultiplier   float64             `json:"conditionMultiplier"`
+	ValidationScore       float64             `json:"validationScore"`
+	ValidationAdjustments []Adjustment        `json:"validationAdjustments"` // Sorted by category
+	ValidationIssues      []ValidationIssue   `json:"validationIssues"`
+	AdjustedMultiplier    float64             `json:"adjustedMultiplier"` // Condition multiplier × validation score
+	FeatureAdditions      []FeatureAddition   `json:"featureAdditions"`
+	FeatureValue          float64             `json:"featureValue"` // Sum of feature additions
+	AgeDepreciation       float64             `json:"ageDepreciation"`
+	BedroomValue          float64             `json:"bedroomValue"`
+	BathroomValue         float64             `json:"bathroomValue"`
+	FinalValue            float64             `json:"finalValue"`
+	Uncertainty           []UncertaintySource `json:"uncertainty"`
+	RelativeUncertainty   float64             `json:"relativeUncertainty"` // Combined standard deviation as a fraction of the value
+}
+
+// sortedAdjustments converts validation adjustments into a slice ordered by category
+func sortedAdjustments(adjustments map[string]float64) []Adjustment {
+	sorted := make([]Adjustment, 0, len(adjustments))
+	for category, factor := range adjustments {
+		sorted = append(sorted, Adjustment{Category: category, Factor: factor})
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Category < sorted[j].Category
+	})
+	return sorted
+}
+
+// Explanation renders the breakdown as human-readable text
+func (b ValuationBreakdown) Explanation() string {
+	var sb strings.Builder
+
+	sb.WriteString("Valuation based on:\n")
+	fmt.Fprintf(&sb, "- Pricing model: %s\n", b.ModelVersion)
+	fmt.Fprintf(&sb, "- Base value: $%.2f per sq ft for %s property\n", b.PricePerSquareFoot, b.PropertyType)
+	if b.PriceIndexRegion != "" {
+		fmt.Fprintf(&sb, "- Price index: %s (factor: %.3f to %s)\n",
+			b.PriceIndexRegion, b.PriceIndexFactor, b.ValuationDate.Format("2006-01-02"))
+	}
+	if b.LocationClass != "" {
+		fmt.Fprintf(&sb, "- Location: %s market (multiplier: %.2f)\n", b.LocationClass, b.LocationMultiplier)
+	} else {
+		sb.WriteString("- Location: unclassified (multiplier: 1.00)\n")
+	}
+	fmt.Fprintf(&sb, "- Condition: %s (base multiplier: %.2f)\n", b.ConditionDescription, b.ConditionMultiplier)
+
+	if len(b.ValidationIssues) > 0 {
+		sb.WriteString("\nCondition validation issues:\n")
+		for _, issue := range b.ValidationIssues {
+			fmt.Fprintf(&sb, "  ! [%s] %s (Severity: %.1f)\n", issue.Category, issue.Description, issue.Severity)
+		}
+		sb.WriteString("\nAdjustment factors applied:\n")
+		for _, adjustment := range b.ValidationAdjustments {
+			fmt.Fprintf(&sb, "  * %s: %.2f\n", adjustment.Category, adjustment.Factor)
+		}
+		fmt.Fprintf(&sb, "  Final multiplier: %.2f\n", b.AdjustedMultiplier)
+	}
+
+	fmt.Fprintf(&sb, "- Feature additions: $%.2f\n", b.FeatureValue)
+	fmt.Fprintf(&sb, "- Age-based depreciation: %.2f\n", b.AgeDepreciation)
+	fmt.Fprintf(&sb, "- Bedroom value: $%.2f\n", b.BedroomValue)
+	fmt.Fprintf(&sb, "- Bathroom value: $%.2f\n", b.BathroomValue)
+	fmt.Fprintf(&sb, "- Relative uncertainty: %.1f%%\n", b.RelativeUncertainty*100)
+	for _, source := range b.Uncertainty {
+		fmt.Fprintf(&sb, "  * %s: %.1f%%\n", source.Source, source.RelativeUncertainty*100)
+	}
+
+	return sb.String()
+}
@@ -1,9 +1,13 @@
 package valuation
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"time"
+
+	"github.com/jsarcade/property-valuation-service/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // BasePricePerSquareFoot represents the base price per square foot for different property types
@@ -42,15 +46,15 @@ var BasePricePerSquareFoot = map[string]float64{
 
 // PropertyCondition represents detailed criteria for property conditions
 type PropertyCondition struct {
-	Multiplier    float64   `json:"multiplier"`
-	Criteria      []string  `json:"criteria"`
-	Description   string    `json:"description"`
-	MinYearBuilt  int       `json:"minYearBuilt"`  // Minimum year built for this condition
-	MaxYearBuilt  int       `json:"maxYearBuilt"`  // Maximum year built for this condition
-	RequiredFeatures []string `json:"requiredFeatures"` // Features that must be present
-	ExcludedFeatures []string `json:"excludedFeatures"` // Features that cannot be present
-	MaintenanceLevel string  `json:"maintenanceLevel"` // Expected maintenance level
-	RenovationStatus string  `json:"renovationStatus"` // Expected renovation status
+	Multiplier    float64   `json:"multiplier" yaml:"multiplier"`
+	Criteria      []string  `json:"criteria" yaml:"criteria"`
+	Description   string    `json:"description" yaml:"description"`
+	MinAge        int       `json:"minAge" yaml:"minAge"`  // Minimum age in years for this condition
+	MaxAge        int       `json:"maxAge" yaml:"maxAge"`  // Maximum age in years for this condition, zero for no limit
+	RequiredFeatures []string `json:"requiredFeatures" yaml:"requiredFeatures"` // Features that must be present
+	ExcludedFeatures []string `json:"excludedFeatures" yaml:"excludedFeatures"` // Features that cannot be present
+	MaintenanceLevel string  `json:"maintenanceLevel" yaml:"maintenanceLevel"` // Expected maintenance level
+	RenovationStatus string  `json:"renovationStatus" yaml:"renovationStatus"` // Expected renovation status
 }
 
 // ValidationIssue represents a specific validation issue with its severity
@@ -58,6 +62,7 @@ type ValidationIssue struct {
 	Description string
 	Severity    float64 // 0.0 to 1.0, where 1.0 is most severe
 	Category    string  // "age", "feature", "maintenance", "renovation"
+	Field       string  // Property field the issue relates to
 }
 
 // ValidationResult represents the complete validation result
@@ -68,24 +73,37 @@ type ValidationResult struct {
 	Adjustments map[string]float64
 }
 
-// ValidateCondition checks if a property meets the criteria for its claimed condition
-func ValidateCondition(property Property, condition PropertyCondition) ValidationResult {
+// YearBuiltRange returns the range of construction years that fall within the
+// condition's age window when a property is valued in the given year
+func (c PropertyCondition) YearBuiltRange(year int) (int, int) {
+	minYearBuilt := 0
+	if c.MaxAge > 0 {
+		minYearBuilt = year - c.MaxAge
+	}
+	return minYearBuilt, year - c.MinAge
+}
+
+// ValidateCondition checks if a property meets the criteria for its claimed condition,
+// measuring the property's age at the given date
+func ValidateCondition(property Property, condition PropertyCondition, date time.Time) ValidationResult {
 	var issues []ValidationIssue
 	adjustments := make(map[string]float64)
 	totalScore := 1.0
 
 	// Validate year built
 	yearScore := 1.0
-	if property.YearBuilt < condition.MinYearBuilt || property.YearBuilt > condition.MaxYearBuilt {
+	minYearBuilt, maxYearBuilt := condition.YearBuiltRange(date.Year())
+	if property.YearBuilt < minYearBuilt || property.YearBuilt > maxYearBuilt {
 		severity := 0.8
-		if property.YearBuilt < condition.MinYearBuilt {
+		if property.YearBuilt < minYearBuilt {
 			severity = 0.9 // More severe if too old
 		}
 		issues = append(issues, ValidationIssue{
 			Description: fmt.Sprintf("Property year built (%d) is outside the acceptable range (%d-%d) for %s condition",
-				property.YearBuilt, condition.MinYearBuilt, condition.MaxYearBuilt, condition.Description),
+				property.YearBuilt, minYearBuilt, maxYearBuilt, condition.Description),
 			Severity: severity,
 			Category: "age",
+			Field:    "year_built",
 		})
 		yearScore = 0.5
 	}
@@ -109,6 +127,7 @@ func ValidateCondition(property Property, condition PropertyCondition) Validatio
 				Description: fmt.Sprintf("Missing required feature: %s", required),
 				Severity:    0.7,
 				Category:    "feature",
+				Field:       "features",
 			})
 		}
 	}
@@ -127,6 +146,7 @@ func ValidateCondition(property Property, condition PropertyCondition) Validatio
 					Description: fmt.Sprintf("Property has excluded feature: %s", excluded),
 					Severity:    0.6,
 					Category:    "feature",
+					Field:       "features",
 				})
 				excludedScore *= 0.8 // 20% reduction for each excluded feature
 			}
@@ -147,6 +167,7 @@ func ValidateCondition(property Property, condition PropertyCondition) Validatio
 				property.MaintenanceLevel, condition.MaintenanceLevel, condition.Description),
 			Severity: severity,
 			Category: "maintenance",
+			Field:    "maintenance_level",
 		})
 		maintenanceScore = 0.7
 	}
@@ -165,6 +186,7 @@ func ValidateCondition(property Property, condition PropertyCondition) Validatio
 				property.RenovationStatus, condition.RenovationStatus, condition.Description),
 			Severity: severity,
 			Category: "renovation",
+			Field:    "renovation_status",
 		})
 		renovationScore = 0.7
 	}
@@ -184,8 +206,8 @@ var ConditionCriteria = map[string]PropertyCondition{
 	"excellent": {
 		Multiplier:    1.40,  // 40% premium for excellent condition
 		Description:   "Like new, fully renovated, premium finishes",
-		MinYearBuilt:  time.Now().Year() - 2,
-		MaxYearBuilt:  time.Now().Year(),
+		MinAge:        0,
+		MaxAge:        2,
 		RequiredFeatures: []string{
 			"energy_efficient",
 			"modern_appliances",
@@ -212,8 +234,8 @@ var ConditionCriteria = map[string]PropertyCondition{
 	"very_good": {
 		Multiplier:    1.25,  // 25% premium for very good condition
 		Description:   "Well maintained, minor updates needed",
-		MinYearBuilt:  time.Now().Year() - 5,
-		MaxYearBuilt:  time.Now().Year(),
+		MinAge:        0,
+		MaxAge:        5,
 		RequiredFeatures: []string{
 			"updated_systems",
 			"modern_appliances",
@@ -237,8 +259,8 @@ var ConditionCriteria = map[string]PropertyCondition{
 	"good": {
 		Multiplier:    1.10,  // 10% premium for good condition
 		Description:   "Standard condition, some wear",
-		MinYearBuilt:  time.Now().Year() - 10,
-		MaxYearBuilt:  time.Now().Year(),
+		MinAge:        0,
+		MaxAge:        10,
 		RequiredFeatures: []string{
 			"functional_systems",
 		},
@@ -261,8 +283,8 @@ var ConditionCriteria = map[string]PropertyCondition{
 	"fair": {
 		Multiplier:    0.90,  // 10% discount for fair condition
 		Description:   "Needs some repairs and updates",
-		MinYearBuilt:  time.Now().Year() - 15,
-		MaxYearBuilt:  time.Now().Year(),
+		MinAge:        0,
+		MaxAge:        15,
 		RequiredFeatures: []string{},
 		ExcludedFeatures: []string{
 			"major_system_failures",
@@ -282,8 +304,8 @@ var ConditionCriteria = map[string]PropertyCondition{
 	"poor": {
 		Multiplier:    0.75,  // 25% discount for poor condition
 		Description:   "Needs significant repairs",
-		MinYearBuilt:  time.Now().Year() - 20,
-		MaxYearBuilt:  time.Now().Year(),
+		MinAge:        0,
+		MaxAge:        20,
 		RequiredFeatures: []string{},
 		ExcludedFeatures: []string{},
 		MaintenanceLevel: "poor",
@@ -301,8 +323,8 @@ var ConditionCriteria = map[string]PropertyCondition{
 	"needs_work": {
 		Multiplier:    0.60,  // 40% discount for needs work
 		Description:   "Major renovation required",
-		MinYearBuilt:  0,
-		MaxYearBuilt:  time.Now().Year() - 20,
+		MinAge:        20,
+		MaxAge:        0,
 		RequiredFeatures: []string{},
 		ExcludedFeatures: []string{},
 		MaintenanceLevel: "very_poor",
@@ -370,85 +392,225 @@ var LocationMultiplier = map[string]float64{
 	"beach":      1.25,  // Beachfront
 }
 
-// CalculateValuation performs the property valuation based on various factors
-func CalculateValuation(property Property) (float64, float64, string) {
+// Value added per bedroom and per bathroom
+const (
+	BedroomValue  = 25000.0
+	BathroomValue = 15000.0
+)
+
+// AgeDepreciation returns the depreciation factor for a property built in yearBuilt,
+// 0.5% per year of age with a maximum of 30%
+func AgeDepreciation(yearBuilt, currentYear int) float64 {
+	age := currentYear - yearBuilt
+	return math.Max(0.7, 1.0-(float64(age)*0.005))
+}
+
+// MarketClassifier classifies a property location into a LocationMultiplier key
+type MarketClassifier interface {
+	Classify(loc Location) (string, bool)
+}
+
+// DefaultPriceIndexRegion is the index region used for properties without a region
+const DefaultPriceIndexRegion = "national"
+
+// PriceIndexer moves prices between dates using regional house-price indices
+type PriceIndexer interface {
+	HasRegion(region string) bool
+	LatestDate(region string) (time.Time, bool)
+	Factor(region string, from, to time.Time) (float64, error)
+}
+
+// PriceIndex is used to index base prices to the valuation date; when nil, or when
+// no series covers the property's region and date, base prices are used as they are
+var PriceIndex PriceIndexer
+
+// PriceIndexRegion returns the index region of a property, defaulting to DefaultPriceIndexRegion
+func PriceIndexRegion(property Property) string {
+	if property.Region == "" {
+		return DefaultPriceIndexRegion
+	}
+	return property.Region
+}
+
+// PriceIndexFactor returns the index region and the factor that moves base prices
+// from the model's price date to the valuation date. The region is empty and the
+// factor 1.0 when no index covers the property, or when the dates are outside it.
+func (m *PricingModel) PriceIndexFactor(property Property, date time.Time) (string, float64, error) {
+	region := PriceIndexRegion(property)
+	if PriceIndex == nil || !PriceIndex.HasRegion(region) {
+		return "", 1.0, nil
+	}
+
+	priceDate := m.PriceDate
+	if priceDate.IsZero() {
+		latest, ok := PriceIndex.LatestDate(region)
+		if !ok {
+			return "", 1.0, nil
+		}
+		priceDate = latest
+	}
+
+	factor, err := PriceIndex.Factor(region, priceDate, date)
+	if err != nil {
+		return "", 1.0, err
+	}
+	return region, factor, nil
+}
+
+// classifyLocation returns the market class and multiplier for a property location
+func (m *PricingModel) classifyLocation(loc Location) (string, float64, bool) {
+	if m.Classifier == nil || loc.IsZero() {
+		return "", 1.0, false
+	}
+	class, ok := m.Classifier.Classify(loc)
+	if !ok {
+		return "", 1.0, false
+	}
+	multiplier, exists := m.LocationMultiplier[class]
+	if !exists {
+		return "", 1.0, false
+	}
+	return class, multiplier, true
+}
+
+// CalculateValuation performs the property valuation as of the given date using the
+// active pricing model and returns the estimated value, the confidence score and a
+// breakdown of each stage
+func CalculateValuation(property Property, date time.Time) (float64, float64, ValuationBreakdown) {
+	return ActiveModel().CalculateValuationAsOf(property, date)
+}
+
+// CalculateValuationAsOf values the property as of the given date: base prices are
+// indexed to the date and the property's age is measured at it
+func (m *PricingModel) CalculateValuationAsOf(property Property, date time.Time) (float64, float64, ValuationBreakdown) {
+	return m.CalculateValuationContext(context.Background(), property, date)
+}
+
+// CalculateValuationContext values the property as of the given date like
+// CalculateValuationAsOf, recording each stage as a child span of the span in ctx
+func (m *PricingModel) CalculateValuationContext(ctx context.Context, property Property, date time.Time) (float64, float64, ValuationBreakdown) {
+	ctx, span := tracing.StartSpan(ctx, "valuation.calculate",
+		attribute.String("valuation.model_version", m.Version),
+		attribute.String("valuation.property_type", property.PropertyType),
+		attribute.String("valuation.date", date.Format(time.DateOnly)),
+	)
+	defer span.End()
+
 	// Get base price per square foot for the property type
-	basePrice, exists := BasePricePerSquareFoot[property.PropertyType]
+	_, stage := tracing.StartSpan(ctx, "valuation.base_price")
+	basePrice, exists := m.BasePricePerSquareFoot[property.PropertyType]
 	if !exists {
-		basePrice = BasePricePerSquareFoot["apartment"] // Default to apartment if type not found
+		basePrice = m.BasePricePerSquareFoot["apartment"] // Default to apartment if type not found
 	}
 
-	// Calculate base value from square footage
-	baseValue := float64(property.SquareFootage) * basePrice
+	// Calculate base value from square footage, indexed from the model's price date
+	indexRegion, indexFactor, _ := m.PriceIndexFactor(property, date) // Validated by callers; unindexed on error
+	baseValue := float64(property.SquareFootage) * basePrice * indexFactor
+	breakdown := ValuationBreakdown{
+		ModelVersion:       m.Version,
+		PropertyType:       property.PropertyType,
+		PricePerSquareFoot: basePrice,
+		ValuationDate:      date,
+		PriceIndexRegion:   indexRegion,
+		PriceIndexFactor:   indexFactor,
+		BaseValue:          baseValue,
+	}
+
+	// Apply location multiplier based on the property's market class
+	locationClass, locationMultiplier, _ := m.classifyLocation(property.Location)
+	baseValue *= locationMultiplier
+	breakdown.LocationClass = locationClass
+	breakdown.LocationMultiplier = locationMultiplier
+	stage.SetAttributes(
+		attribute.Float64("valuation.price_per_square_foot", basePrice),
+		attribute.Bool("valuation.property_type_known", exists),
+		attribute.String("valuation.price_index_region", indexRegion),
+		attribute.Float64("valuation.price_index_factor", indexFactor),
+		attribute.String("valuation.location_class", locationClass),
+		attribute.Float64("valuation.location_multiplier", locationMultiplier),
+		attribute.Float64("valuation.base_value", breakdown.BaseValue),
+	)
+	stage.End()
 
 	// Apply condition multiplier with detailed criteria
-	condition, exists := ConditionCriteria[property.Condition]
+	_, stage = tracing.StartSpan(ctx, "valuation.condition")
+	condition, exists := m.ConditionCriteria[property.Condition]
 	if !exists {
-		condition = ConditionCriteria["good"] // Default to good if condition not found
+		condition = m.ConditionCriteria["good"] // Default to good if condition not found
 	}
 
 	// Validate the property against the condition criteria
-	validationResult := ValidateCondition(property, condition)
+	validationResult := ValidateCondition(property, condition, date)
 	
 	// Apply the validation adjustments to the multiplier
 	adjustedMultiplier := condition.Multiplier * validationResult.TotalScore
 	baseValue *= adjustedMultiplier
+	breakdown.ConditionDescription = condition.Description
+	breakdown.ConditionMultiplier = condition.Multiplier
+	breakdown.ValidationScore = validationResult.TotalScore
+	breakdown.ValidationAdjustments = sortedAdjustments(validationResult.Adjustments)
+	breakdown.ValidationIssues = validationResult.Issues
+	breakdown.AdjustedMultiplier = adjustedMultiplier
+	stage.SetAttributes(
+		attribute.String("valuation.condition", property.Condition),
+		attribute.Float64("valuation.condition_multiplier", condition.Multiplier),
+		attribute.Float64("valuation.validation_score", validationResult.TotalScore),
+		attribute.Int("valuation.validation_issues", len(validationResult.Issues)),
+		attribute.Float64("valuation.adjusted_multiplier", adjustedMultiplier),
+	)
+	stage.End()
 
 	// Add value for features
+	_, stage = tracing.StartSpan(ctx, "valuation.features")
 	featureValue := 0.0
 	for _, feature := range property.Features {
-		if value, exists := FeatureValue[feature]; exists {
+		if value, exists := m.FeatureValue[feature]; exists {
 			featureValue += value
+			breakdown.FeatureAdditions = append(breakdown.FeatureAdditions, FeatureAddition{Feature: feature, Value: value})
 		}
 	}
 	baseValue += featureValue
+	breakdown.FeatureValue = featureValue
+	stage.SetAttributes(
+		attribute.Int("valuation.features", len(property.Features)),
+		attribute.Int("valuation.valued_features", len(breakdown.FeatureAdditions)),
+		attribute.Float64("valuation.feature_value", featureValue),
+	)
+	stage.End()
 
 	// Adjust for age (depreciation)
-	currentYear := time.Now().Year()
-	age := currentYear - property.YearBuilt
-	ageDepreciation := math.Max(0.7, 1.0-(float64(age)*0.005)) // Maximum 30% depreciation
+	_, stage = tracing.StartSpan(ctx, "valuation.depreciation")
+	ageDepreciation := AgeDepreciation(property.YearBuilt, date.Year())
 	baseValue *= ageDepreciation
+	breakdown.AgeDepreciation = ageDepreciation
+	stage.SetAttributes(
+		attribute.Int("valuation.age", date.Year()-property.YearBuilt),
+		attribute.Float64("valuation.age_depreciation", ageDepreciation),
+	)
+	stage.End()
 
 	// Adjust for number of bedrooms and bathrooms
-	bedroomValue := float64(property.Bedrooms) * 25000.0
-	bathroomValue := float64(property.Bathrooms) * 15000.0
+	bedroomValue := float64(property.Bedrooms) * BedroomValue
+	bathroomValue := float64(property.Bathrooms) * BathroomValue
 	baseValue += bedroomValue + bathroomValue
+	breakdown.BedroomValue = bedroomValue
+	breakdown.BathroomValue = bathroomValue
+	breakdown.FinalValue = baseValue
 
-	// Calculate confidence score
-	confidence := 0.85 // Base confidence
-	if len(property.Features) > 0 {
-		confidence += 0.05 // Higher confidence if features are provided
-	}
-	if property.Condition != "" {
-		confidence += 0.05 // Higher confidence if condition is specified
-	}
-	if validationResult.IsValid {
-		confidence += 0.05 // Higher confidence if condition criteria are met
-	}
-	confidence = math.Min(0.95, confidence) // Cap confidence at 95%
-
-	// Generate detailed explanation
-	explanation := "Valuation based on:\n"
-	explanation += fmt.Sprintf("- Base value: $%.2f per sq ft for %s property\n", basePrice, property.PropertyType)
-	explanation += fmt.Sprintf("- Condition: %s (base multiplier: %.2f)\n", condition.Description, condition.Multiplier)
-	
-	if !validationResult.IsValid {
-		explanation += "\nCondition validation issues:\n"
-		for _, issue := range validationResult.Issues {
-			explanation += fmt.Sprintf("  ! [%s] %s (Severity: %.1f)\n", 
-				issue.Category, issue.Description, issue.Severity)
-		}
-		explanation += "\nAdjustment factors applied:\n"
-		for category, adjustment := range validationResult.Adjustments {
-			explanation += fmt.Sprintf("  * %s: %.2f\n", category, adjustment)
-		}
-		explanation += fmt.Sprintf("  Final multiplier: %.2f\n", adjustedMultiplier)
-	}
-
-	explanation += fmt.Sprintf("- Feature additions: $%.2f\n", featureValue)
-	explanation += fmt.Sprintf("- Age-based depreciation: %.2f\n", ageDepreciation)
-	explanation += fmt.Sprintf("- Bedroom value: $%.2f\n", bedroomValue)
-	explanation += fmt.Sprintf("- Bathroom value: $%.2f\n", bathroomValue)
+	// Derive confidence from input completeness, validation severity and model uncertainty
+	_, stage = tracing.StartSpan(ctx, "valuation.confidence")
+	breakdown.Uncertainty = costUncertainty(property, breakdown)
+	breakdown.RelativeUncertainty = combinedUncertainty(breakdown.Uncertainty)
+	confidence := confidenceFromUncertainty(breakdown.RelativeUncertainty)
+	stage.SetAttributes(
+		attribute.Float64("valuation.relative_uncertainty", breakdown.RelativeUncertainty),
+		attribute.Float64("valuation.confidence", confidence),
+	)
+	stage.End()
 
-	return baseValue, confidence, explanation
-} 
\ No newline at end of file
+	span.SetAttributes(
+		attribute.Float64("valuation.value", baseValue),
+		attribute.Float64("valuation.confidence", confidence),
+	)
+	return baseValue, confidence, breakdown
+}
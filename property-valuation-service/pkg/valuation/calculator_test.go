@@ -1,13 +1,19 @@
 package valuation
 
 import (
+	"context"
 	"math"
+	"slices"
+	"strings"
 	"testing"
 	"time"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 )
 
 // printCalculationDetails prints the detailed steps of the valuation calculation
-func printCalculationDetails(t *testing.T, property Property, value float64, confidence float64, explanation string) {
+func printCalculationDetails(t *testing.T, property Property, date time.Time, value float64, confidence float64, explanation string) {
 	t.Logf("\nDetailed Calculation for %s:", property.Address)
 	t.Logf("Base price per sq ft: $%.2f", BasePricePerSquareFoot[property.PropertyType])
 	t.Logf("Base value: $%.2f", float64(property.SquareFootage)*BasePricePerSquareFoot[property.PropertyType])
@@ -15,7 +21,7 @@ func printCalculationDetails(t *testing.T, property Property, value float64, con
 	condition := ConditionCriteria[property.Condition]
 	t.Logf("Condition multiplier: %.2f", condition.Multiplier)
 	
-	validationResult := ValidateCondition(property, condition)
+	validationResult := ValidateCondition(property, condition, date)
 	t.Logf("Validation score: %.2f", validationResult.TotalScore)
 	t.Logf("Adjusted multiplier: %.2f", condition.Multiplier*validationResult.TotalScore)
 	
@@ -27,7 +33,7 @@ func printCalculationDetails(t *testing.T, property Property, value float64, con
 		}
 	}
 	
-	age := time.Now().Year() - property.YearBuilt
+	age := date.Year() - property.YearBuilt
 	ageDepreciation := math.Max(0.7, 1.0-(float64(age)*0.005))
 	t.Logf("Age depreciation: %.2f", ageDepreciation)
 	
@@ -42,7 +48,8 @@ func printCalculationDetails(t *testing.T, property Property, value float64, con
 }
 
 func TestCalculateValuation(t *testing.T) {
-	currentYear := time.Now().Year()
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	currentYear := now.Year()
 
 	tests := []struct {
 		name           string
@@ -205,10 +212,15 @@ func TestCalculateValuation(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			value, confidence, explanation := CalculateValuation(tt.property)
+			value, confidence, breakdown := CalculateValuation(tt.property, now)
 
 			// Print detailed calculation steps
-			printCalculationDetails(t, tt.property, value, confidence, explanation)
+			printCalculationDetails(t, tt.property, now, value, confidence, breakdown.Explanation())
+
+			// Check the breakdown is consistent with the returned value
+			if breakdown.FinalValue != value {
+				t.Errorf("Breakdown final value = %.2f, want %.2f", breakdown.FinalValue, value)
+			}
 
 			// Check if the value is within 20% of expected
 			if value < tt.expectedValue*0.8 || value > tt.expectedValue*1.2 {
@@ -225,11 +237,292 @@ func TestCalculateValuation(t *testing.T) {
 			if !exists {
 				condition = ConditionCriteria["good"]
 			}
-			validationResult := ValidateCondition(tt.property, condition)
+			validationResult := ValidateCondition(tt.property, condition, now)
 			hasIssues := len(validationResult.Issues) > 0
 			if hasIssues != tt.shouldHaveIssues {
 				t.Errorf("Has issues = %v, want %v", hasIssues, tt.shouldHaveIssues)
 			}
 		})
 	}
-} 
\ No newline at end of file
+} 
+
+// fixedClassifier classifies every location into the same market class
+type fixedClassifier string
+
+func (c fixedClassifier) Classify(loc Location) (string, bool) {
+	return string(c), c != ""
+}
+
+func TestCalculateValuationLocation(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	property := Property{
+		Address:          "1 Ocean Dr",
+		PropertyType:     "villa",
+		Bedrooms:         4,
+		Bathrooms:        3,
+		SquareFootage:    3000,
+		YearBuilt:        now.Year() - 8,
+		Condition:        "good",
+		MaintenanceLevel: "good",
+		RenovationStatus: "standard",
+		Features:         []string{"functional_systems", "pool"},
+		Location:         Location{Latitude: 25.79, Longitude: -80.13},
+	}
+
+	model := BuiltinModel()
+	ruralValue, _, _ := model.WithClassifier(fixedClassifier("rural")).CalculateValuationAsOf(property, now)
+
+	beachValue, _, breakdown := model.WithClassifier(fixedClassifier("beach")).CalculateValuationAsOf(property, now)
+	explanation := breakdown.Explanation()
+
+	if beachValue <= ruralValue {
+		t.Errorf("Beach value = %.2f, want greater than rural value %.2f", beachValue, ruralValue)
+	}
+	if breakdown.LocationClass != "beach" || breakdown.LocationMultiplier != LocationMultiplier["beach"] {
+		t.Errorf("Breakdown location = (%q, %.2f), want beach", breakdown.LocationClass, breakdown.LocationMultiplier)
+	}
+	if !strings.Contains(explanation, "Location: beach market") {
+		t.Errorf("Explanation does not report the location class:\n%s", explanation)
+	}
+
+	unclassifiedValue, _, breakdown := model.WithClassifier(fixedClassifier("")).CalculateValuationAsOf(property, now)
+	explanation = breakdown.Explanation()
+	if !strings.Contains(explanation, "Location: unclassified") {
+		t.Errorf("Explanation does not report an unclassified location:\n%s", explanation)
+	}
+	if unclassifiedValue <= ruralValue || unclassifiedValue >= beachValue {
+		t.Errorf("Unclassified value = %.2f, want between rural %.2f and beach %.2f", unclassifiedValue, ruralValue, beachValue)
+	}
+}
+
+func TestValuationBreakdown(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	property := Property{
+		Address:          "12 Elm St",
+		PropertyType:     "house",
+		Bedrooms:         3,
+		Bathrooms:        2,
+		SquareFootage:    2000,
+		YearBuilt:        now.Year() - 8,
+		Condition:        "good",
+		MaintenanceLevel: "fair",
+		RenovationStatus: "standard",
+		Features:         []string{"functional_systems", "garage", "pool"},
+	}
+
+	value, _, breakdown := CalculateValuation(property, now)
+
+	if breakdown.BaseValue != 2000*BasePricePerSquareFoot["house"] {
+		t.Errorf("BaseValue = %.2f, want %.2f", breakdown.BaseValue, 2000*BasePricePerSquareFoot["house"])
+	}
+	if len(breakdown.FeatureAdditions) != 2 || breakdown.FeatureValue != FeatureValue["garage"]+FeatureValue["pool"] {
+		t.Errorf("Feature additions = %v (total %.2f), want garage and pool", breakdown.FeatureAdditions, breakdown.FeatureValue)
+	}
+	if breakdown.ValidationScore != 0.7 || len(breakdown.ValidationIssues) != 1 {
+		t.Errorf("Validation = %.2f with %d issues, want 0.70 with 1 maintenance issue", breakdown.ValidationScore, len(breakdown.ValidationIssues))
+	}
+	for i := 1; i < len(breakdown.ValidationAdjustments); i++ {
+		if breakdown.ValidationAdjustments[i-1].Category > breakdown.ValidationAdjustments[i].Category {
+			t.Errorf("Validation adjustments are not sorted: %v", breakdown.ValidationAdjustments)
+		}
+	}
+
+	// Recompute the final value from the breakdown stages
+	recomputed := (breakdown.BaseValue*breakdown.LocationMultiplier*breakdown.AdjustedMultiplier+breakdown.FeatureValue)*
+		breakdown.AgeDepreciation + breakdown.BedroomValue + breakdown.BathroomValue
+	if math.Abs(recomputed-value) > 0.01 {
+		t.Errorf("Recomputed value = %.2f, want %.2f", recomputed, value)
+	}
+}
+
+// linearIndex is a price index for a single region that grows by a fixed level per year
+type linearIndex struct {
+	region  string
+	start   time.Time
+	perYear float64
+}
+
+func (ix linearIndex) level(date time.Time) float64 {
+	return 100 + ix.perYear*date.Sub(ix.start).Hours()/(24*365.25)
+}
+
+func (ix linearIndex) HasRegion(region string) bool { return region == ix.region }
+
+func (ix linearIndex) LatestDate(region string) (time.Time, bool) {
+	return ix.start.AddDate(10, 0, 0), region == ix.region
+}
+
+func (ix linearIndex) Factor(region string, from, to time.Time) (float64, error) {
+	return ix.level(to) / ix.level(from), nil
+}
+
+func TestCalculateValuationAsOf(t *testing.T) {
+	defer func(previous PriceIndexer) { PriceIndex = previous }(PriceIndex)
+
+	priceDate := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	model := BuiltinModel()
+	model.PriceDate = priceDate
+	property := Property{
+		PropertyType:     "house",
+		Bedrooms:         3,
+		Bathrooms:        2,
+		SquareFootage:    2000,
+		YearBuilt:        2000,
+		Condition:        "good",
+		MaintenanceLevel: "good",
+		RenovationStatus: "standard",
+		Region:           "miami",
+	}
+
+	// Without an index only the age depends on the valuation date
+	PriceIndex = nil
+	_, _, unindexed := model.CalculateValuationAsOf(property, priceDate.AddDate(-2, 0, 0))
+	if unindexed.PriceIndexRegion != "" || unindexed.PriceIndexFactor != 1 || unindexed.AgeDepreciation != AgeDepreciation(2000, 2024) {
+		t.Errorf("Unexpected unindexed breakdown: %+v", unindexed)
+	}
+
+	PriceIndex = linearIndex{region: "miami", start: priceDate.AddDate(-5, 0, 0), perYear: 10}
+	tests := []struct {
+		name       string
+		date       time.Time
+		wantFactor float64
+	}{
+		{"Price date", priceDate, 1},
+		{"Back-dated", priceDate.AddDate(-2, 0, 0), 130.0 / 150.0},
+		{"Rolled forward", priceDate.AddDate(1, 0, 0), 160.0 / 150.0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, breakdown := model.CalculateValuationAsOf(property, tt.date)
+			if breakdown.PriceIndexRegion != "miami" || math.Abs(breakdown.PriceIndexFactor-tt.wantFactor) > 0.001 {
+				t.Errorf("Price index = (%q, %.4f), want (miami, %.4f)",
+					breakdown.PriceIndexRegion, breakdown.PriceIndexFactor, tt.wantFactor)
+			}
+			wantBase := float64(property.SquareFootage) * BasePricePerSquareFoot["house"] * breakdown.PriceIndexFactor
+			if math.Abs(breakdown.BaseValue-wantBase) > 0.01 {
+				t.Errorf("Base value = %.2f, want %.2f", breakdown.BaseValue, wantBase)
+			}
+		})
+	}
+
+	// Properties outside every indexed region use base prices as they are
+	property.Region = "aspen"
+	_, _, breakdown := model.CalculateValuationAsOf(property, priceDate.AddDate(-2, 0, 0))
+	if breakdown.PriceIndexFactor != 1 {
+		t.Errorf("Price index factor for an unindexed region = %.4f, want 1", breakdown.PriceIndexFactor)
+	}
+}
+
+func TestConditionAgeWindowFollowsValuationDate(t *testing.T) {
+	date := time.Date(2026, 12, 31, 23, 0, 0, 0, time.UTC)
+
+	property := Property{
+		Address:          "7 New Year Ct",
+		PropertyType:     "house",
+		Bedrooms:         3,
+		Bathrooms:        2,
+		SquareFootage:    2000,
+		YearBuilt:        2024,
+		Condition:        "excellent",
+		MaintenanceLevel: "excellent",
+		RenovationStatus: "recent",
+		Features:         []string{"energy_efficient", "modern_appliances", "smart_home"},
+	}
+
+	before, _, breakdown := CalculateValuation(property, date)
+	if len(breakdown.ValidationIssues) != 0 {
+		t.Errorf("Validation issues in 2026 = %v, want none", breakdown.ValidationIssues)
+	}
+
+	// Crossing New Year ages the property out of the excellent window without a restart
+	date = date.Add(2 * time.Hour)
+	after, _, breakdown := CalculateValuation(property, date)
+	if len(breakdown.ValidationIssues) != 1 || breakdown.ValidationIssues[0].Category != "age" {
+		t.Fatalf("Validation issues in 2027 = %v, want one age issue", breakdown.ValidationIssues)
+	}
+	if want := "acceptable range (2025-2027)"; !strings.Contains(breakdown.ValidationIssues[0].Description, want) {
+		t.Errorf("Age issue = %q, want it to mention %q", breakdown.ValidationIssues[0].Description, want)
+	}
+	if after >= before {
+		t.Errorf("Value in 2027 = %.2f, want less than %.2f in 2026", after, before)
+	}
+	if !breakdown.ValuationDate.Equal(date) {
+		t.Errorf("Valuation date = %v, want %v", breakdown.ValuationDate, date)
+	}
+}
+
+func TestYearBuiltRange(t *testing.T) {
+	tests := []struct {
+		condition string
+		wantMin   int
+		wantMax   int
+	}{
+		{"excellent", 2024, 2026},
+		{"good", 2016, 2026},
+		{"needs_work", 0, 2006},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.condition, func(t *testing.T) {
+			minYearBuilt, maxYearBuilt := ConditionCriteria[tt.condition].YearBuiltRange(2026)
+			if minYearBuilt != tt.wantMin || maxYearBuilt != tt.wantMax {
+				t.Errorf("YearBuiltRange(2026) = %d-%d, want %d-%d", minYearBuilt, maxYearBuilt, tt.wantMin, tt.wantMax)
+			}
+		})
+	}
+}
+
+func TestCalculateValuationSpans(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	model := BuiltinModel()
+	property := Property{
+		PropertyType:     "house",
+		Bedrooms:         3,
+		Bathrooms:        2,
+		SquareFootage:    2000,
+		YearBuilt:        2000,
+		Condition:        "good",
+		MaintenanceLevel: "good",
+		RenovationStatus: "standard",
+		Features:         []string{"pool"},
+	}
+	date := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// Valuations outside a recorded trace, e.g. Monte Carlo simulations, record nothing
+	model.CalculateValuationAsOf(property, date)
+	if spans := recorder.Ended(); len(spans) != 0 {
+		t.Fatalf("Recorded %d spans without a parent span, want 0", len(spans))
+	}
+
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "request")
+	value, _, _ := model.CalculateValuationContext(ctx, property, date)
+	parent.End()
+	if want, _, _ := model.CalculateValuationAsOf(property, date); value != want {
+		t.Errorf("Traced value = %.2f, want %.2f", value, want)
+	}
+
+	spans := recorder.Ended()
+	var calculate sdktrace.ReadOnlySpan
+	for _, span := range spans {
+		if span.Name() == "valuation.calculate" {
+			calculate = span
+		}
+	}
+	if calculate == nil {
+		t.Fatalf("No valuation.calculate span recorded")
+	}
+	if calculate.Parent().SpanID() != parent.SpanContext().SpanID() {
+		t.Errorf("valuation.calculate is not a child of the request span")
+	}
+	var stages []string
+	for _, span := range spans {
+		if span.Parent().SpanID() == calculate.SpanContext().SpanID() {
+			stages = append(stages, span.Name())
+		}
+	}
+	want := []string{"valuation.base_price", "valuation.condition", "valuation.features", "valuation.depreciation", "valuation.confidence"}
+	if !slices.Equal(stages, want) {
+		t.Errorf("Stage spans = %v, want %v", stages, want)
+	}
+}
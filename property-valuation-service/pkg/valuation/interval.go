@@ -0,0 +1,251 @@
+package valuation
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"sort"
+	"time"
+)
+
+// Relative uncertainty of a cost approach value by source, as a standard deviation
+// expressed as a fraction of the value
+const (
+	ModelUncertainty          = 0.06 // Error of the pricing tables for a fully described property
+	LocationUncertainty       = 0.05 // Location missing or outside every known market
+	FeatureUncertainty        = 0.03 // No features listed, so the property's amenities are unknown
+	ValidationUncertaintyRate = 0.03 // Per unit of condition-validation issue severity
+	maxValidationUncertainty  = 0.15
+)
+
+// Methods used to derive a value range
+const (
+	RangeMethodAnalytical = "analytical"
+	RangeMethodMonteCarlo = "monte_carlo"
+)
+
+// Defaults applied to zero interval options
+const (
+	DefaultConfidenceLevel        = 0.90
+	DefaultSimulations            = 1000
+	DefaultSquareFootageTolerance = 0.05
+	DefaultConditionUncertainty   = 0.30
+)
+
+// reportedPercentiles lists the percentiles reported by a Monte Carlo simulation
+var reportedPercentiles = []float64{5, 10, 25, 50, 75, 90, 95}
+
+// UncertaintySource represents one contribution to the uncertainty of a value
+type UncertaintySource struct {
+	Source              string  `json:"source"`              // "model", "location", "features", "condition"
+	RelativeUncertainty float64 `json:"relativeUncertainty"` // Standard deviation as a fraction of the value
+}
+
+// IntervalOptions controls how the value range of a valuation is derived
+type IntervalOptions struct {
+	ConfidenceLevel        float64 // Probability that the value lies within the range, 0.0 to 1.0
+	MonteCarlo             bool    // Simulate uncertain inputs instead of using the analytical range
+	Simulations            int     // Number of Monte Carlo runs
+	SquareFootageTolerance float64 // Standard deviation of the square footage as a fraction of it
+	ConditionUncertainty   float64 // Probability that the condition is one level better or worse than rated
+	Seed                   uint64  // Makes a simulation reproducible; a random seed is used when zero
+}
+
+// withDefaults returns the options with defaults applied to zero fields
+func (o IntervalOptions) withDefaults() IntervalOptions {
+	if o.ConfidenceLevel == 0 {
+		o.ConfidenceLevel = DefaultConfidenceLevel
+	}
+	if o.Simulations == 0 {
+		o.Simulations = DefaultSimulations
+	}
+	if o.SquareFootageTolerance == 0 {
+		o.SquareFootageTolerance = DefaultSquareFootageTolerance
+	}
+	if o.ConditionUncertainty == 0 {
+		o.ConditionUncertainty = DefaultConditionUncertainty
+	}
+	return o
+}
+
+// Percentile represents the value at a percentile of a simulated distribution
+type Percentile struct {
+	Percentile float64 `json:"percentile"` // 0 to 100
+	Value      float64 `json:"value"`
+}
+
+// ValueRange represents the range a value lies within at a confidence level
+type ValueRange struct {
+	Low             float64      `json:"low"`
+	High            float64      `json:"high"`
+	ConfidenceLevel float64      `json:"confidenceLevel"`
+	Method          string       `json:"method"`                // "analytical" or "monte_carlo"
+	Percentiles     []Percentile `json:"percentiles,omitempty"` // Set by Monte Carlo simulations
+}
+
+// costUncertainty returns the sources of uncertainty of a cost approach value
+func costUncertainty(property Property, breakdown ValuationBreakdown) []UncertaintySource {
+	sources := []UncertaintySource{{Source: "model", RelativeUncertainty: ModelUncertainty}}
+
+	if breakdown.LocationClass == "" {
+		sources = append(sources, UncertaintySource{Source: "location", RelativeUncertainty: LocationUncertainty})
+	}
+	if len(property.Features) == 0 {
+		sources = append(sources, UncertaintySource{Source: "features", RelativeUncertainty: FeatureUncertainty})
+	}
+
+	severity := 0.0
+	for _, issue := range breakdown.ValidationIssues {
+		severity += issue.Severity
+	}
+	if severity > 0 {
+		sources = append(sources, UncertaintySource{
+			Source:              "condition",
+			RelativeUncertainty: math.Min(maxValidationUncertainty, severity*ValidationUncertaintyRate),
+		})
+	}
+
+	return sources
+}
+
+// combinedUncertainty combines independent sources of uncertainty, skipping the excluded ones
+func combinedUncertainty(sources []UncertaintySource, exclude ...string) float64 {
+	variance := 0.0
+	for _, source := range sources {
+		if !containsString(exclude, source.Source) {
+			variance += source.RelativeUncertainty * source.RelativeUncertainty
+		}
+	}
+	return math.Sqrt(variance)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// confidenceFromUncertainty converts a relative uncertainty into a confidence score
+func confidenceFromUncertainty(uncertainty float64) float64 {
+	return math.Max(0.5, math.Min(0.95, 1-uncertainty))
+}
+
+// AnalyticalRange derives the value range at a confidence level from the confidence
+// score of a valuation, treating one minus the score as the relative standard
+// deviation of a log-normally distributed value
+func AnalyticalRange(value, confidence, level float64) ValueRange {
+	if level == 0 {
+		level = DefaultConfidenceLevel
+	}
+	sigma := 1 - confidence
+	z := math.Sqrt2 * math.Erfinv(level)
+
+	return ValueRange{
+		Low:             value * math.Exp(-z*sigma),
+		High:            value * math.Exp(z*sigma),
+		ConfidenceLevel: level,
+		Method:          RangeMethodAnalytical,
+	}
+}
+
+// SimulateRange values the property as of a date repeatedly with its square footage and
+// condition perturbed within their uncertainty, applies the remaining model uncertainty
+// to each run and reports the percentiles of the resulting values
+func (m *PricingModel) SimulateRange(property Property, date time.Time, options IntervalOptions) ValueRange {
+	options = options.withDefaults()
+	seed := options.Seed
+	if seed == 0 {
+		seed = rand.Uint64()
+	}
+	rng := rand.New(rand.NewPCG(seed, seed))
+
+	// Sources not simulated below are applied as log-normal noise
+	_, _, breakdown := m.CalculateValuationAsOf(property, date)
+	residual := combinedUncertainty(costUncertainty(property, breakdown), "condition")
+	conditions := m.conditionsByMultiplier()
+
+	values := make([]float64, options.Simulations)
+	for i := range values {
+		simulated := property
+		simulated.SquareFootage = max(1, int(math.Round(
+			float64(property.SquareFootage)*(1+options.SquareFootageTolerance*rng.NormFloat64()))))
+		simulated.Condition = shiftCondition(conditions, property.Condition, options.ConditionUncertainty, rng)
+
+		value, _, _ := m.CalculateValuationAsOf(simulated, date)
+		values[i] = value * math.Exp(residual*rng.NormFloat64())
+	}
+	sort.Float64s(values)
+
+	valueRange := ValueRange{
+		Low:             quantile(values, (1-options.ConfidenceLevel)/2),
+		High:            quantile(values, (1+options.ConfidenceLevel)/2),
+		ConfidenceLevel: options.ConfidenceLevel,
+		Method:          RangeMethodMonteCarlo,
+	}
+	for _, percentile := range reportedPercentiles {
+		valueRange.Percentiles = append(valueRange.Percentiles, Percentile{
+			Percentile: percentile,
+			Value:      quantile(values, percentile/100),
+		})
+	}
+	return valueRange
+}
+
+// conditionsByMultiplier returns the model's condition ratings from worst to best
+func (m *PricingModel) conditionsByMultiplier() []string {
+	conditions := make([]string, 0, len(m.ConditionCriteria))
+	for condition := range m.ConditionCriteria {
+		conditions = append(conditions, condition)
+	}
+	sort.Slice(conditions, func(i, j int) bool {
+		return m.ConditionCriteria[conditions[i]].Multiplier < m.ConditionCriteria[conditions[j]].Multiplier
+	})
+	return conditions
+}
+
+// shiftCondition moves a condition rating one level down or up, each with half the given probability
+func shiftCondition(conditions []string, condition string, probability float64, rng *rand.Rand) string {
+	index := -1
+	for i, c := range conditions {
+		if c == condition {
+			index = i
+		}
+	}
+	if index < 0 {
+		return condition
+	}
+
+	switch draw := rng.Float64(); {
+	case draw < probability/2 && index > 0:
+		return conditions[index-1]
+	case draw >= probability/2 && draw < probability && index < len(conditions)-1:
+		return conditions[index+1]
+	default:
+		return condition
+	}
+}
+
+// quantile returns the q-quantile of sorted values using linear interpolation
+func quantile(sorted []float64, q float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	position := q * float64(len(sorted)-1)
+	lower := int(math.Floor(position))
+	upper := int(math.Ceil(position))
+	fraction := position - float64(lower)
+	return sorted[lower]*(1-fraction) + sorted[upper]*fraction
+}
+
+// Explanation renders the value range as human-readable text
+func (r ValueRange) Explanation() string {
+	explanation := fmt.Sprintf("%.0f%% value range (%s): $%.2f to $%.2f\n",
+		r.ConfidenceLevel*100, r.Method, r.Low, r.High)
+	for _, percentile := range r.Percentiles {
+		explanation += fmt.Sprintf("  * P%.0f: $%.2f\n", percentile.Percentile, percentile.Value)
+	}
+	return explanation
+}
@@ -0,0 +1,158 @@
+package valuation
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestCostUncertainty(t *testing.T) {
+	tests := []struct {
+		name        string
+		property    Property
+		breakdown   ValuationBreakdown
+		wantSources []string
+	}{
+		{
+			name:        "Fully described property",
+			property:    Property{Features: []string{"garage"}},
+			breakdown:   ValuationBreakdown{LocationClass: "urban"},
+			wantSources: []string{"model"},
+		},
+		{
+			name:        "Unclassified location and no features",
+			property:    Property{},
+			breakdown:   ValuationBreakdown{},
+			wantSources: []string{"model", "location", "features"},
+		},
+		{
+			name:     "Condition validation issues",
+			property: Property{Features: []string{"garage"}},
+			breakdown: ValuationBreakdown{
+				LocationClass:    "urban",
+				ValidationIssues: []ValidationIssue{{Severity: 0.5}, {Severity: 0.7}},
+			},
+			wantSources: []string{"model", "condition"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sources := costUncertainty(tt.property, tt.breakdown)
+			if len(sources) != len(tt.wantSources) {
+				t.Fatalf("Got %d sources %v, want %v", len(sources), sources, tt.wantSources)
+			}
+			for i, source := range sources {
+				if source.Source != tt.wantSources[i] {
+					t.Errorf("Source %d = %q, want %q", i, source.Source, tt.wantSources[i])
+				}
+			}
+		})
+	}
+}
+
+func TestCalculateValuationConfidence(t *testing.T) {
+	property := Property{
+		PropertyType:     "house",
+		SquareFootage:    2000,
+		YearBuilt:        time.Now().Year() - 5,
+		Condition:        "good",
+		MaintenanceLevel: "good",
+		RenovationStatus: "standard",
+	}
+	_, bare, breakdown := CalculateValuation(property, time.Now())
+	if want := confidenceFromUncertainty(breakdown.RelativeUncertainty); bare != want {
+		t.Errorf("Confidence = %.4f, want %.4f", bare, want)
+	}
+
+	// Listing features removes a source of uncertainty
+	property.Features = []string{"functional_systems"}
+	_, described, _ := CalculateValuation(property, time.Now())
+	if described <= bare {
+		t.Errorf("Confidence with features = %.4f, want above %.4f", described, bare)
+	}
+}
+
+func TestAnalyticalRange(t *testing.T) {
+	narrow := AnalyticalRange(500000, 0.92, 0.8)
+	wide := AnalyticalRange(500000, 0.92, 0.95)
+	uncertain := AnalyticalRange(500000, 0.7, 0.8)
+
+	if narrow.Low >= 500000 || narrow.High <= 500000 {
+		t.Errorf("Range [%.2f, %.2f] does not contain the value", narrow.Low, narrow.High)
+	}
+	if wide.Low >= narrow.Low || wide.High <= narrow.High {
+		t.Errorf("95%% range [%.2f, %.2f] is not wider than 80%% range [%.2f, %.2f]",
+			wide.Low, wide.High, narrow.Low, narrow.High)
+	}
+	if uncertain.High-uncertain.Low <= narrow.High-narrow.Low {
+		t.Errorf("Lower confidence did not widen the range")
+	}
+
+	// The range is symmetric around the value on a log scale
+	if math.Abs(narrow.Low*narrow.High-500000*500000) > 1 {
+		t.Errorf("Range [%.2f, %.2f] is not log-symmetric around the value", narrow.Low, narrow.High)
+	}
+	if AnalyticalRange(500000, 0.92, 0).ConfidenceLevel != DefaultConfidenceLevel {
+		t.Errorf("Zero level did not default to %.2f", DefaultConfidenceLevel)
+	}
+}
+
+func TestSimulateRange(t *testing.T) {
+	property := Property{
+		PropertyType:     "house",
+		SquareFootage:    2000,
+		YearBuilt:        time.Now().Year() - 5,
+		Condition:        "good",
+		MaintenanceLevel: "good",
+		RenovationStatus: "standard",
+		Features:         []string{"functional_systems"},
+	}
+	model := BuiltinModel()
+	value, _, _ := model.CalculateValuationAsOf(property, time.Now())
+	options := IntervalOptions{ConfidenceLevel: 0.9, Simulations: 2000, Seed: 42}
+
+	first := model.SimulateRange(property, time.Now(), options)
+	second := model.SimulateRange(property, time.Now(), options)
+	if first.Low != second.Low || first.High != second.High {
+		t.Errorf("Simulations with the same seed differ: %v and %v", first, second)
+	}
+
+	if first.Method != RangeMethodMonteCarlo || len(first.Percentiles) != len(reportedPercentiles) {
+		t.Fatalf("Unexpected simulation result: %+v", first)
+	}
+	for i := 1; i < len(first.Percentiles); i++ {
+		if first.Percentiles[i].Value < first.Percentiles[i-1].Value {
+			t.Errorf("Percentiles are not ordered: %v", first.Percentiles)
+		}
+	}
+	if first.Low >= value || first.High <= value {
+		t.Errorf("Range [%.2f, %.2f] does not contain the point value %.2f", first.Low, first.High, value)
+	}
+
+	// Wider square footage tolerance widens the range
+	options.SquareFootageTolerance = 0.3
+	loose := model.SimulateRange(property, time.Now(), options)
+	if loose.High-loose.Low <= first.High-first.Low {
+		t.Errorf("Loose tolerance range [%.2f, %.2f] is not wider than [%.2f, %.2f]",
+			loose.Low, loose.High, first.Low, first.High)
+	}
+}
+
+func TestQuantile(t *testing.T) {
+	values := []float64{10, 20, 30, 40, 50}
+	tests := []struct {
+		q    float64
+		want float64
+	}{
+		{0, 10},
+		{0.5, 30},
+		{0.875, 45},
+		{1, 50},
+	}
+	for _, tt := range tests {
+		if got := quantile(values, tt.q); got != tt.want {
+			t.Errorf("quantile(%v) = %v, want %v", tt.q, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package valuation
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// BuiltinModelVersion is the version reported when the built-in pricing tables are active
+const BuiltinModelVersion = "builtin"
+
+// PricingModel represents a versioned set of pricing tables used by the calculator
+type PricingModel struct {
+	Version                string                        `json:"version" yaml:"version"`
+	BasePricePerSquareFoot map[string]float64            `json:"basePricePerSquareFoot" yaml:"basePricePerSquareFoot"`
+	ConditionCriteria      map[string]PropertyCondition  `json:"conditionCriteria" yaml:"conditionCriteria"`
+	FeatureValue           map[string]float64            `json:"featureValue" yaml:"featureValue"`
+	LocationMultiplier     map[string]float64            `json:"locationMultiplier" yaml:"locationMultiplier"`
+	ReconciliationWeights  map[string]map[string]float64 `json:"reconciliationWeights" yaml:"reconciliationWeights"`
+	PriceDate              time.Time                     `json:"priceDate" yaml:"priceDate,omitempty"` // Date base prices were observed; latest index date when zero
+
+	// Classifier classifies property locations into LocationMultiplier keys; when nil,
+	// or when a location cannot be classified, no location multiplier is applied
+	Classifier MarketClassifier `json:"-" yaml:"-"`
+}
+
+// activeModel holds the pricing model used by CalculateValuation. It is swapped
+// atomically so that in-flight valuations keep the model they started with.
+var activeModel atomic.Pointer[PricingModel]
+
+func init() {
+	activeModel.Store(BuiltinModel())
+}
+
+// BuiltinModel returns a pricing model backed by the package-level pricing tables
+func BuiltinModel() *PricingModel {
+	return &PricingModel{
+		Version:                BuiltinModelVersion,
+		BasePricePerSquareFoot: BasePricePerSquareFoot,
+		ConditionCriteria:      ConditionCriteria,
+		FeatureValue:           FeatureValue,
+		LocationMultiplier:     LocationMultiplier,
+		ReconciliationWeights:  ReconciliationWeights,
+	}
+}
+
+// ActiveModel returns the pricing model currently used for valuations
+func ActiveModel() *PricingModel {
+	return activeModel.Load()
+}
+
+// SetActiveModel atomically replaces the pricing model used for valuations
+func SetActiveModel(model *PricingModel) {
+	activeModel.Store(model)
+}
+
+// WithClassifier returns a copy of the model classifying property locations with c
+func (m *PricingModel) WithClassifier(c MarketClassifier) *PricingModel {
+	model := *m
+	model.Classifier = c
+	return &model
+}
+
+// Validate checks that the pricing model is complete and internally consistent
+func (m *PricingModel) Validate() error {
+	if m.Version == "" {
+		return fmt.Errorf("pricing model version is required")
+	}
+
+	if len(m.BasePricePerSquareFoot) == 0 {
+		return fmt.Errorf("pricing model %s: basePricePerSquareFoot is empty", m.Version)
+	}
+	if _, exists := m.BasePricePerSquareFoot["apartment"]; !exists {
+		return fmt.Errorf("pricing model %s: basePricePerSquareFoot must define the default apartment type", m.Version)
+	}
+	for propertyType, price := range m.BasePricePerSquareFoot {
+		if price <= 0 {
+			return fmt.Errorf("pricing model %s: price per sq ft for %s must be positive, got %.2f", m.Version, propertyType, price)
+		}
+	}
+
+	if _, exists := m.ConditionCriteria["good"]; !exists {
+		return fmt.Errorf("pricing model %s: conditionCriteria must define the default good condition", m.Version)
+	}
+	for name, condition := range m.ConditionCriteria {
+		if condition.Multiplier <= 0 {
+			return fmt.Errorf("pricing model %s: multiplier for %s condition must be positive, got %.2f", m.Version, name, condition.Multiplier)
+		}
+		if condition.MinAge < 0 || condition.MaxAge < 0 {
+			return fmt.Errorf("pricing model %s: age window of %s condition must not be negative, got %d-%d",
+				m.Version, name, condition.MinAge, condition.MaxAge)
+		}
+		if condition.MaxAge > 0 && condition.MinAge > condition.MaxAge {
+			return fmt.Errorf("pricing model %s: %s condition has minAge %d above maxAge %d",
+				m.Version, name, condition.MinAge, condition.MaxAge)
+		}
+	}
+
+	for feature, value := range m.FeatureValue {
+		if value < 0 {
+			return fmt.Errorf("pricing model %s: value of feature %s must not be negative, got %.2f", m.Version, feature, value)
+		}
+	}
+
+	for class, multiplier := range m.LocationMultiplier {
+		if multiplier <= 0 {
+			return fmt.Errorf("pricing model %s: multiplier for %s location must be positive, got %.2f", m.Version, class, multiplier)
+		}
+	}
+
+	for propertyType, weights := range m.ReconciliationWeights {
+		for approach, weight := range weights {
+			if weight < 0 {
+				return fmt.Errorf("pricing model %s: weight of %s approach for %s must not be negative, got %.2f",
+					m.Version, approach, propertyType, weight)
+			}
+		}
+	}
+
+	return nil
+}
@@ -12,9 +12,15 @@ type Property struct {
 	Location          Location  `json:"location"`
 	MaintenanceLevel  string    `json:"maintenanceLevel"`
 	RenovationStatus  string    `json:"renovationStatus"`
+	Region            string    `json:"region"` // Price index region; DefaultPriceIndexRegion when empty
 }
 
 type Location struct {
 	Latitude  float64 `json:"latitude"`
 	Longitude float64 `json:"longitude"`
-} 
\ No newline at end of file
+} 
+
+// IsZero reports whether no coordinates were provided for the location
+func (l Location) IsZero() bool {
+	return l.Latitude == 0 && l.Longitude == 0
+}
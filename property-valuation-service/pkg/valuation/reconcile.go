@@ -0,0 +1,161 @@
+package valuation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/jsarcade/property-valuation-service/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// DefaultWeightsKey is the ReconciliationWeights entry used for property types without their own weights
+const DefaultWeightsKey = "default"
+
+// commercialWeights favour the income approach for income-producing property types
+var commercialWeights = map[string]float64{
+	ApproachIncome:          0.60,
+	ApproachSalesComparison: 0.25,
+	ApproachCost:            0.15,
+}
+
+// ReconciliationWeights represents the weight of each approach per property type when
+// several approaches are reconciled into a final value
+var ReconciliationWeights = map[string]map[string]float64{
+	// Residential: market evidence from comparable sales is the strongest indicator
+	DefaultWeightsKey: {
+		ApproachSalesComparison: 0.70,
+		ApproachCost:            0.30,
+	},
+
+	// Commercial
+	"office_class_a":     commercialWeights,
+	"office_class_b":     commercialWeights,
+	"office_class_c":     commercialWeights,
+	"retail_high_street": commercialWeights,
+	"retail_mall":        commercialWeights,
+	"retail_strip":       commercialWeights,
+	"warehouse":          commercialWeights,
+	"industrial":         commercialWeights,
+	"logistics":          commercialWeights,
+	"manufacturing":      commercialWeights,
+}
+
+// ErrNoApproachApplied is returned when no registered approach could value a subject
+var ErrNoApproachApplied = errors.New("no valuation approach could value the property")
+
+// ApproachResult represents the outcome of one approach in a reconciliation
+type ApproachResult struct {
+	Appraisal
+	Weight float64 // Normalised weight in the reconciled value, 0.0 to 1.0
+	Err    error   // Set when the approach failed; failed approaches get no weight
+}
+
+// Reconciliation represents a final value reconciled from several approaches
+type Reconciliation struct {
+	Value      float64
+	Confidence float64
+	Approaches []ApproachResult
+}
+
+// weightsFor returns the reconciliation weights for a property type, using the
+// built-in weights when the model does not configure any
+func (m *PricingModel) weightsFor(propertyType string) map[string]float64 {
+	weights := m.ReconciliationWeights
+	if weights == nil {
+		weights = ReconciliationWeights
+	}
+	if typeWeights, exists := weights[propertyType]; exists {
+		return typeWeights
+	}
+	return weights[DefaultWeightsKey]
+}
+
+// Reconcile runs every registered approach that applies to the subject and
+// reconciles their values using the model's weights for the property type.
+// Approaches without a configured weight are reported but do not contribute;
+// if none of the successful approaches has a weight, they are weighted equally.
+func (r *Registry) Reconcile(ctx context.Context, model *PricingModel, subject Subject) (Reconciliation, error) {
+	var results []ApproachResult
+	for _, valuer := range r.Valuers() {
+		if !valuer.Applies(subject) {
+			continue
+		}
+		approachCtx, span := tracing.StartSpan(ctx, "valuation.approach", attribute.String("valuation.approach", valuer.Approach()))
+		appraisal, err := valuer.Value(approachCtx, model, subject)
+		tracing.EndSpan(span, err)
+		if err != nil {
+			appraisal = Appraisal{Approach: valuer.Approach()}
+		}
+		results = append(results, ApproachResult{Appraisal: appraisal, Err: err})
+	}
+
+	weights := model.weightsFor(subject.Property.PropertyType)
+	totalWeight, succeeded := 0.0, 0
+	for i := range results {
+		if results[i].Err == nil {
+			results[i].Weight = weights[results[i].Approach]
+			totalWeight += results[i].Weight
+			succeeded++
+		}
+	}
+	if succeeded == 0 {
+		return Reconciliation{Approaches: results}, ErrNoApproachApplied
+	}
+
+	reconciliation := Reconciliation{Approaches: results}
+	for i := range reconciliation.Approaches {
+		result := &reconciliation.Approaches[i]
+		if result.Err != nil {
+			continue
+		}
+		if totalWeight > 0 {
+			result.Weight /= totalWeight
+		} else {
+			result.Weight = 1.0 / float64(succeeded)
+		}
+		reconciliation.Value += result.Value * result.Weight
+		reconciliation.Confidence += result.Confidence * result.Weight
+	}
+
+	// Disagreement between the approaches lowers confidence in the final value
+	if reconciliation.Value > 0 {
+		dispersion := 0.0
+		for _, result := range reconciliation.Approaches {
+			if result.Err == nil {
+				dispersion += result.Weight * math.Abs(result.Value-reconciliation.Value)
+			}
+		}
+		reconciliation.Confidence -= math.Min(0.2, dispersion/reconciliation.Value)
+	}
+
+	return reconciliation, nil
+}
+
+// Explanation renders the reconciliation as human-readable text, followed by the
+// explanation of each approach
+func (r Reconciliation) Explanation() string {
+	var sb strings.Builder
+
+	sb.WriteString("Reconciled value based on:\n")
+	for _, result := range r.Approaches {
+		if result.Err != nil {
+			fmt.Fprintf(&sb, "- %s: not used (%v)\n", result.Approach, result.Err)
+			continue
+		}
+		fmt.Fprintf(&sb, "- %s: $%.2f (confidence %.2f, weight %.2f)\n",
+			result.Approach, result.Value, result.Confidence, result.Weight)
+	}
+	fmt.Fprintf(&sb, "- Final value: $%.2f\n", r.Value)
+
+	for _, result := range r.Approaches {
+		if result.Err == nil && result.Explanation != "" {
+			sb.WriteString("\n")
+			sb.WriteString(result.Explanation)
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,144 @@
+package valuation
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+)
+
+// stubValuer is a valuer returning a fixed appraisal or error
+type stubValuer struct {
+	approach string
+	applies  bool
+	value    float64
+	err      error
+}
+
+func (v stubValuer) Approach() string             { return v.approach }
+func (v stubValuer) Applies(subject Subject) bool { return v.applies }
+
+func (v stubValuer) Value(_ context.Context, model *PricingModel, subject Subject) (Appraisal, error) {
+	if v.err != nil {
+		return Appraisal{}, v.err
+	}
+	return Appraisal{Approach: v.approach, Value: v.value, Confidence: 0.9}, nil
+}
+
+func TestRegistryRegister(t *testing.T) {
+	registry, err := NewRegistry(stubValuer{approach: ApproachCost}, stubValuer{approach: ApproachIncome})
+	if err != nil {
+		t.Fatalf("NewRegistry failed: %v", err)
+	}
+	if err := registry.Register(stubValuer{approach: ApproachCost}); err == nil {
+		t.Errorf("Expected error registering a duplicate approach")
+	}
+
+	valuers := registry.Valuers()
+	if len(valuers) != 2 || valuers[0].Approach() != ApproachCost || valuers[1].Approach() != ApproachIncome {
+		t.Errorf("Valuers() = %v, want cost then income", valuers)
+	}
+	if _, exists := registry.Get(ApproachSalesComparison); exists {
+		t.Errorf("Get(%q) found an unregistered approach", ApproachSalesComparison)
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	model := &PricingModel{
+		Version: "test",
+		ReconciliationWeights: map[string]map[string]float64{
+			DefaultWeightsKey: {ApproachCost: 0.25, ApproachSalesComparison: 0.75},
+			"warehouse":       {ApproachIncome: 1},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		propertyType string
+		valuers      []Valuer
+		wantValue    float64
+		wantWeights  map[string]float64
+		wantErr      error
+	}{
+		{
+			name:         "Weighted by property type",
+			propertyType: "house",
+			valuers: []Valuer{
+				stubValuer{approach: ApproachCost, applies: true, value: 400000},
+				stubValuer{approach: ApproachSalesComparison, applies: true, value: 500000},
+			},
+			wantValue:   475000,
+			wantWeights: map[string]float64{ApproachCost: 0.25, ApproachSalesComparison: 0.75},
+		},
+		{
+			name:         "Failed approach gets no weight",
+			propertyType: "house",
+			valuers: []Valuer{
+				stubValuer{approach: ApproachCost, applies: true, value: 400000},
+				stubValuer{approach: ApproachSalesComparison, applies: true, err: errors.New("no sales")},
+			},
+			wantValue:   400000,
+			wantWeights: map[string]float64{ApproachCost: 1, ApproachSalesComparison: 0},
+		},
+		{
+			name:         "Inapplicable approach is skipped",
+			propertyType: "house",
+			valuers: []Valuer{
+				stubValuer{approach: ApproachCost, applies: true, value: 400000},
+				stubValuer{approach: ApproachIncome, applies: false, value: 900000},
+			},
+			wantValue:   400000,
+			wantWeights: map[string]float64{ApproachCost: 1},
+		},
+		{
+			name:         "Equal weights when none configured",
+			propertyType: "warehouse",
+			valuers: []Valuer{
+				stubValuer{approach: ApproachCost, applies: true, value: 400000},
+				stubValuer{approach: ApproachSalesComparison, applies: true, value: 600000},
+			},
+			wantValue:   500000,
+			wantWeights: map[string]float64{ApproachCost: 0.5, ApproachSalesComparison: 0.5},
+		},
+		{
+			name:         "No approach succeeded",
+			propertyType: "house",
+			valuers: []Valuer{
+				stubValuer{approach: ApproachCost, applies: true, err: errors.New("failed")},
+			},
+			wantErr: ErrNoApproachApplied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry, err := NewRegistry(tt.valuers...)
+			if err != nil {
+				t.Fatalf("NewRegistry failed: %v", err)
+			}
+
+			reconciliation, err := registry.Reconcile(context.Background(), model, Subject{Property: Property{PropertyType: tt.propertyType}})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Reconcile() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			if math.Abs(reconciliation.Value-tt.wantValue) > 0.01 {
+				t.Errorf("Value = %.2f, want %.2f", reconciliation.Value, tt.wantValue)
+			}
+			if len(reconciliation.Approaches) != len(tt.wantWeights) {
+				t.Fatalf("Got %d approaches, want %d", len(reconciliation.Approaches), len(tt.wantWeights))
+			}
+			for _, approach := range reconciliation.Approaches {
+				if math.Abs(approach.Weight-tt.wantWeights[approach.Approach]) > 0.0001 {
+					t.Errorf("Weight of %s = %.4f, want %.4f", approach.Approach, approach.Weight, tt.wantWeights[approach.Approach])
+				}
+			}
+			if reconciliation.Confidence <= 0 || reconciliation.Confidence > 0.9 {
+				t.Errorf("Confidence = %.2f, want between 0 and 0.9", reconciliation.Confidence)
+			}
+		})
+	}
+}
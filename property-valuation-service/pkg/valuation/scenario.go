@@ -0,0 +1,126 @@
+package valuation
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Modification represents a single change to a property in a what-if scenario.
+// Exactly one change is set; empty strings and zero square footage mean no change.
+type Modification struct {
+	AddFeature       string  `json:"addFeature,omitempty"`
+	RemoveFeature    string  `json:"removeFeature,omitempty"`
+	Condition        string  `json:"condition,omitempty"`
+	MaintenanceLevel string  `json:"maintenanceLevel,omitempty"`
+	RenovationStatus string  `json:"renovationStatus,omitempty"`
+	AddSquareFootage int     `json:"addSquareFootage,omitempty"`
+	Cost             float64 `json:"cost"` // Cost of making the change
+}
+
+// Apply returns a copy of the property with the modification applied
+func (m Modification) Apply(property Property) Property {
+	switch {
+	case m.AddFeature != "":
+		property.Features = append(slices.Clone(property.Features), m.AddFeature)
+	case m.RemoveFeature != "":
+		property.Features = slices.DeleteFunc(slices.Clone(property.Features), func(feature string) bool {
+			return feature == m.RemoveFeature
+		})
+	case m.Condition != "":
+		property.Condition = m.Condition
+	case m.MaintenanceLevel != "":
+		property.MaintenanceLevel = m.MaintenanceLevel
+	case m.RenovationStatus != "":
+		property.RenovationStatus = m.RenovationStatus
+	case m.AddSquareFootage != 0:
+		property.SquareFootage += m.AddSquareFootage
+	}
+	return property
+}
+
+// Description renders the change made by the modification to a property
+func (m Modification) Description(property Property) string {
+	switch {
+	case m.AddFeature != "":
+		return fmt.Sprintf("add %s", m.AddFeature)
+	case m.RemoveFeature != "":
+		return fmt.Sprintf("remove %s", m.RemoveFeature)
+	case m.Condition != "":
+		return fmt.Sprintf("condition from %s to %s", property.Condition, m.Condition)
+	case m.MaintenanceLevel != "":
+		return fmt.Sprintf("maintenance level from %s to %s", property.MaintenanceLevel, m.MaintenanceLevel)
+	case m.RenovationStatus != "":
+		return fmt.Sprintf("renovation status from %s to %s", property.RenovationStatus, m.RenovationStatus)
+	default:
+		return fmt.Sprintf("add %d sq ft", m.AddSquareFootage)
+	}
+}
+
+// Scenario represents a named set of modifications applied together
+type Scenario struct {
+	Name          string         `json:"name"`
+	Modifications []Modification `json:"modifications"`
+}
+
+// ModificationImpact represents the value a single modification adds on top of
+// the modifications applied before it
+type ModificationImpact struct {
+	Description string  `json:"description"`
+	Cost        float64 `json:"cost"`
+	ValueDelta  float64 `json:"valueDelta"`
+}
+
+// ScenarioResult represents the outcome of a what-if scenario
+type ScenarioResult struct {
+	Name       string               `json:"name"`
+	Property   Property             `json:"property"` // Property with every modification applied
+	Value      float64              `json:"value"`
+	ValueDelta float64              `json:"valueDelta"` // Value minus the base value
+	Cost       float64              `json:"cost"`       // Sum of the modification costs
+	ROI        float64              `json:"roi"`        // (Value delta − cost) / cost; zero when the scenario costs nothing
+	Impacts    []ModificationImpact `json:"impacts"`
+	Breakdown  ValuationBreakdown   `json:"breakdown"`
+}
+
+// ScenarioAnalysis represents the base valuation of a property and the outcome of each scenario
+type ScenarioAnalysis struct {
+	BaseValue     float64            `json:"baseValue"`
+	BaseBreakdown ValuationBreakdown `json:"baseBreakdown"`
+	Scenarios     []ScenarioResult   `json:"scenarios"`
+}
+
+// SimulateScenarios values the property as of the given date as is and with the
+// modifications of each scenario applied in order, attributing the value change to
+// each modification. The property and scenarios must have been validated beforehand.
+func (m *PricingModel) SimulateScenarios(property Property, scenarios []Scenario, date time.Time) ScenarioAnalysis {
+	baseValue, _, baseBreakdown := m.CalculateValuationAsOf(property, date)
+	analysis := ScenarioAnalysis{BaseValue: baseValue, BaseBreakdown: baseBreakdown}
+
+	for _, scenario := range scenarios {
+		result := ScenarioResult{Name: scenario.Name, Property: property}
+		previous := baseValue
+		for _, modification := range scenario.Modifications {
+			description := modification.Description(result.Property)
+			result.Property = modification.Apply(result.Property)
+
+			value, _, _ := m.CalculateValuationAsOf(result.Property, date)
+			result.Impacts = append(result.Impacts, ModificationImpact{
+				Description: description,
+				Cost:        modification.Cost,
+				ValueDelta:  value - previous,
+			})
+			result.Cost += modification.Cost
+			previous = value
+		}
+
+		result.Value, _, result.Breakdown = m.CalculateValuationAsOf(result.Property, date)
+		result.ValueDelta = result.Value - baseValue
+		if result.Cost > 0 {
+			result.ROI = (result.ValueDelta - result.Cost) / result.Cost
+		}
+		analysis.Scenarios = append(analysis.Scenarios, result)
+	}
+
+	return analysis
+}
@@ -0,0 +1,89 @@
+package valuation
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestModificationApply(t *testing.T) {
+	base := Property{SquareFootage: 2000, Condition: "fair", Features: []string{"garage", "pool"}}
+
+	tests := []struct {
+		name         string
+		modification Modification
+		check        func(Property) bool
+	}{
+		{"Add feature", Modification{AddFeature: "solar_panels"}, func(p Property) bool {
+			return len(p.Features) == 3 && p.Features[2] == "solar_panels"
+		}},
+		{"Remove feature", Modification{RemoveFeature: "garage"}, func(p Property) bool {
+			return len(p.Features) == 1 && p.Features[0] == "pool"
+		}},
+		{"Change condition", Modification{Condition: "good"}, func(p Property) bool {
+			return p.Condition == "good"
+		}},
+		{"Add square footage", Modification{AddSquareFootage: 400}, func(p Property) bool {
+			return p.SquareFootage == 2400
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if modified := tt.modification.Apply(base); !tt.check(modified) {
+				t.Errorf("Apply() = %+v, unexpected result", modified)
+			}
+			if len(base.Features) != 2 || base.Features[0] != "garage" || base.Condition != "fair" || base.SquareFootage != 2000 {
+				t.Errorf("Apply() modified the base property: %+v", base)
+			}
+		})
+	}
+}
+
+func TestSimulateScenarios(t *testing.T) {
+	property := Property{
+		PropertyType:     "house",
+		Bedrooms:         3,
+		Bathrooms:        2,
+		SquareFootage:    2000,
+		YearBuilt:        2021,
+		Condition:        "fair",
+		MaintenanceLevel: "good",
+		RenovationStatus: "standard",
+		Features:         []string{"garage"},
+	}
+	scenarios := []Scenario{
+		{Name: "Solar and repairs", Modifications: []Modification{
+			{AddFeature: "solar_panels", Cost: 20000},
+			{Condition: "good", Cost: 30000},
+		}},
+		{Name: "Free", Modifications: []Modification{{MaintenanceLevel: "excellent"}}},
+	}
+
+	analysis := BuiltinModel().SimulateScenarios(property, scenarios, time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC))
+	if len(analysis.Scenarios) != 2 {
+		t.Fatalf("Got %d scenario results, want 2", len(analysis.Scenarios))
+	}
+
+	result := analysis.Scenarios[0]
+	if result.Cost != 50000 || result.ValueDelta <= 0 {
+		t.Errorf("Cost = %.2f, value delta = %.2f; want cost 50000 and a positive delta", result.Cost, result.ValueDelta)
+	}
+	impactTotal := 0.0
+	for _, impact := range result.Impacts {
+		impactTotal += impact.ValueDelta
+	}
+	if math.Abs(impactTotal-result.ValueDelta) > 0.01 {
+		t.Errorf("Impacts sum to %.2f, want the value delta %.2f", impactTotal, result.ValueDelta)
+	}
+	if want := (result.ValueDelta - result.Cost) / result.Cost; math.Abs(result.ROI-want) > 1e-9 {
+		t.Errorf("ROI = %.4f, want %.4f", result.ROI, want)
+	}
+	if result.Impacts[1].Description != "condition from fair to good" {
+		t.Errorf("Impact description = %q, want %q", result.Impacts[1].Description, "condition from fair to good")
+	}
+
+	if analysis.Scenarios[1].ROI != 0 {
+		t.Errorf("ROI of a scenario without cost = %.4f, want 0", analysis.Scenarios[1].ROI)
+	}
+}
@@ -0,0 +1,79 @@
+package valuation
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"time"
+)
+
+// Variations applied to each input by a sensitivity analysis
+const (
+	SquareFootageSensitivity = 0.10 // Square footage is varied by ±10%
+	YearBuiltSensitivity     = 5    // Year built is varied by ±5 years
+)
+
+// SensitivityFactor represents the value of a property at a low and a high setting
+// of one input, with every other input unchanged; one bar of a tornado chart
+type SensitivityFactor struct {
+	Input     string  `json:"input"`     // "square_footage", "condition", "year_built", "feature:<name>"
+	LowInput  string  `json:"lowInput"`  // Low setting of the input, e.g. "1800 sq ft"
+	HighInput string  `json:"highInput"` // High setting of the input
+	LowValue  float64 `json:"lowValue"`  // Value with the input at its low setting
+	HighValue float64 `json:"highValue"` // Value with the input at its high setting
+	Swing     float64 `json:"swing"`     // Absolute difference between the two values
+}
+
+// Sensitivity varies each input of the property in turn and returns the impact of
+// each on the value as of a date, ranked from the largest swing to the smallest
+func (m *PricingModel) Sensitivity(property Property, date time.Time) []SensitivityFactor {
+	var factors []SensitivityFactor
+	vary := func(input, lowInput, highInput string, low, high Property) {
+		lowValue, _, _ := m.CalculateValuationAsOf(low, date)
+		highValue, _, _ := m.CalculateValuationAsOf(high, date)
+		factors = append(factors, SensitivityFactor{
+			Input:     input,
+			LowInput:  lowInput,
+			HighInput: highInput,
+			LowValue:  lowValue,
+			HighValue: highValue,
+			Swing:     math.Abs(highValue - lowValue),
+		})
+	}
+
+	// Square footage ±10%
+	low, high := property, property
+	low.SquareFootage = int(math.Round(float64(property.SquareFootage) * (1 - SquareFootageSensitivity)))
+	high.SquareFootage = int(math.Round(float64(property.SquareFootage) * (1 + SquareFootageSensitivity)))
+	vary("square_footage", fmt.Sprintf("%d sq ft", low.SquareFootage), fmt.Sprintf("%d sq ft", high.SquareFootage), low, high)
+
+	// One condition step worse and better
+	conditions := m.conditionsByMultiplier()
+	if index := slices.Index(conditions, property.Condition); index >= 0 {
+		low, high := property, property
+		low.Condition = conditions[max(0, index-1)]
+		high.Condition = conditions[min(len(conditions)-1, index+1)]
+		vary("condition", low.Condition, high.Condition, low, high)
+	}
+
+	// Year built ±5, never later than the valuation year
+	low, high = property, property
+	low.YearBuilt = property.YearBuilt - YearBuiltSensitivity
+	high.YearBuilt = min(date.Year(), property.YearBuilt+YearBuiltSensitivity)
+	vary("year_built", fmt.Sprint(low.YearBuilt), fmt.Sprint(high.YearBuilt), low, high)
+
+	// Each feature without and with it
+	for _, feature := range property.Features {
+		without := property
+		without.Features = slices.DeleteFunc(slices.Clone(property.Features), func(f string) bool {
+			return f == feature
+		})
+		vary("feature:"+feature, "without "+feature, "with "+feature, without, property)
+	}
+
+	sort.SliceStable(factors, func(i, j int) bool {
+		return factors[i].Swing > factors[j].Swing
+	})
+	return factors
+}
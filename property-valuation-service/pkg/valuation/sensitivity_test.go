@@ -0,0 +1,56 @@
+package valuation
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSensitivity(t *testing.T) {
+	currentYear := time.Now().Year()
+	property := Property{
+		PropertyType:     "house",
+		Bedrooms:         3,
+		Bathrooms:        2,
+		SquareFootage:    2000,
+		YearBuilt:        currentYear - 2,
+		Condition:        "excellent",
+		MaintenanceLevel: "excellent",
+		RenovationStatus: "recent",
+		Features:         []string{"pool", "garage"},
+	}
+
+	factors := BuiltinModel().Sensitivity(property, time.Now())
+	byInput := make(map[string]SensitivityFactor)
+	for i, factor := range factors {
+		byInput[factor.Input] = factor
+		if i > 0 && factor.Swing > factors[i-1].Swing {
+			t.Errorf("Factors are not ranked by swing: %s (%.2f) after %s (%.2f)",
+				factor.Input, factor.Swing, factors[i-1].Input, factors[i-1].Swing)
+		}
+	}
+
+	for _, input := range []string{"square_footage", "condition", "year_built", "feature:pool", "feature:garage"} {
+		if _, exists := byInput[input]; !exists {
+			t.Errorf("Missing sensitivity factor for %s", input)
+		}
+	}
+
+	if sqft := byInput["square_footage"]; sqft.LowInput != "1800 sq ft" || sqft.HighInput != "2200 sq ft" || sqft.HighValue <= sqft.LowValue {
+		t.Errorf("Unexpected square footage factor: %+v", sqft)
+	}
+
+	// Excellent is the best condition, so the high setting keeps it
+	if condition := byInput["condition"]; condition.HighInput != "excellent" || condition.LowInput != "very_good" {
+		t.Errorf("Unexpected condition factor: %+v", condition)
+	}
+
+	// A property cannot be built in the future
+	if year := byInput["year_built"]; year.HighInput != time.Now().Format("2006") {
+		t.Errorf("Year built high input = %s, want the current year", year.HighInput)
+	}
+
+	value, _, _ := CalculateValuation(property, time.Now())
+	if pool := byInput["feature:pool"]; pool.HighValue != value || pool.LowValue >= value {
+		t.Errorf("Unexpected pool factor: %+v (base value %.2f)", pool, value)
+	}
+}
@@ -0,0 +1,96 @@
+package valuation
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Names of the built-in valuation approaches
+const (
+	ApproachCost            = "cost"
+	ApproachSalesComparison = "sales_comparison"
+	ApproachIncome          = "income"
+)
+
+// Subject represents a property to value together with approach-specific inputs
+type Subject struct {
+	Property Property
+	AsOf     time.Time      // Date the property is valued at
+	Inputs   map[string]any // Approach-specific inputs keyed by approach name
+}
+
+// Appraisal represents the value of a property indicated by a single approach
+type Appraisal struct {
+	Approach    string
+	Value       float64
+	Confidence  float64
+	Explanation string
+	Details     any // Approach-specific details, e.g. a ValuationBreakdown for the cost approach
+}
+
+// Valuer values properties using a single valuation approach
+type Valuer interface {
+	// Approach returns the unique name of the approach, used for registration and weights
+	Approach() string
+	// Applies reports whether the approach can value the subject; approaches that
+	// do not apply are skipped when several approaches are reconciled
+	Applies(subject Subject) bool
+	// Value values the subject using the tables of the given pricing model; ctx
+	// carries the trace the valuation is recorded in
+	Value(ctx context.Context, model *PricingModel, subject Subject) (Appraisal, error)
+}
+
+// Registry holds the valuers available to the service
+type Registry struct {
+	mu      sync.RWMutex
+	valuers map[string]Valuer
+	order   []string
+}
+
+// NewRegistry creates a registry with the given valuers registered
+func NewRegistry(valuers ...Valuer) (*Registry, error) {
+	registry := &Registry{valuers: make(map[string]Valuer)}
+	for _, valuer := range valuers {
+		if err := registry.Register(valuer); err != nil {
+			return nil, err
+		}
+	}
+	return registry, nil
+}
+
+// Register adds a valuer to the registry; approach names must be unique
+func (r *Registry) Register(valuer Valuer) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	approach := valuer.Approach()
+	if _, exists := r.valuers[approach]; exists {
+		return fmt.Errorf("valuer for approach %q is already registered", approach)
+	}
+	r.valuers[approach] = valuer
+	r.order = append(r.order, approach)
+	return nil
+}
+
+// Get returns the valuer registered for an approach
+func (r *Registry) Get(approach string) (Valuer, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	valuer, exists := r.valuers[approach]
+	return valuer, exists
+}
+
+// Valuers returns the registered valuers in registration order
+func (r *Registry) Valuers() []Valuer {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	valuers := make([]Valuer, 0, len(r.order))
+	for _, approach := range r.order {
+		valuers = append(valuers, r.valuers[approach])
+	}
+	return valuers
+}
@@ -0,0 +1,9 @@
+package proto
+
+import _ "embed"
+
+// OpenAPISpec is the OpenAPI v2 specification of the REST gateway, generated from
+// valuation.proto and the HTTP bindings in valuation_gateway.yaml
+//
+//go:embed valuation.swagger.json
+var OpenAPISpec []byte
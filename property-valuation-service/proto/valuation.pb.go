@@ -9,6 +9,7 @@ package proto
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,6 +22,62 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ValuationMethod selects the approach used to value a property
+type ValuationMethod int32
+
+const (
+	ValuationMethod_VALUATION_METHOD_UNSPECIFIED      ValuationMethod = 0 // Defaults to the cost approach
+	ValuationMethod_VALUATION_METHOD_COST             ValuationMethod = 1 // Price per square foot with condition, feature and age adjustments
+	ValuationMethod_VALUATION_METHOD_SALES_COMPARISON ValuationMethod = 2 // Adjusted prices of recent comparable sales
+	ValuationMethod_VALUATION_METHOD_INCOME           ValuationMethod = 3 // Capitalized net operating income (commercial types only)
+	ValuationMethod_VALUATION_METHOD_RECONCILED       ValuationMethod = 4 // All applicable approaches, weighted by property type
+)
+
+// Enum value maps for ValuationMethod.
+var (
+	ValuationMethod_name = map[int32]string{
+		0: "VALUATION_METHOD_UNSPECIFIED",
+		1: "VALUATION_METHOD_COST",
+		2: "VALUATION_METHOD_SALES_COMPARISON",
+		3: "VALUATION_METHOD_INCOME",
+		4: "VALUATION_METHOD_RECONCILED",
+	}
+	ValuationMethod_value = map[string]int32{
+		"VALUATION_METHOD_UNSPECIFIED":      0,
+		"VALUATION_METHOD_COST":             1,
+		"VALUATION_METHOD_SALES_COMPARISON": 2,
+		"VALUATION_METHOD_INCOME":           3,
+		"VALUATION_METHOD_RECONCILED":       4,
+	}
+)
+
+func (x ValuationMethod) Enum() *ValuationMethod {
+	p := new(ValuationMethod)
+	*p = x
+	return p
+}
+
+func (x ValuationMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ValuationMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_valuation_proto_enumTypes[0].Descriptor()
+}
+
+func (ValuationMethod) Type() protoreflect.EnumType {
+	return &file_proto_valuation_proto_enumTypes[0]
+}
+
+func (x ValuationMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ValuationMethod.Descriptor instead.
+func (ValuationMethod) EnumDescriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{0}
+}
+
 // Property represents a real estate property
 type Property struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
@@ -34,6 +91,8 @@ type Property struct {
 	MaintenanceLevel string                 `protobuf:"bytes,8,opt,name=maintenance_level,json=maintenanceLevel,proto3" json:"maintenance_level,omitempty"`
 	RenovationStatus string                 `protobuf:"bytes,9,opt,name=renovation_status,json=renovationStatus,proto3" json:"renovation_status,omitempty"`
 	Features         []string               `protobuf:"bytes,10,rep,name=features,proto3" json:"features,omitempty"`
+	Location         *Location              `protobuf:"bytes,11,opt,name=location,proto3" json:"location,omitempty"`
+	Region           string                 `protobuf:"bytes,12,opt,name=region,proto3" json:"region,omitempty"` // House-price index region; "national" when empty
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -138,31 +197,43 @@ func (x *Property) GetFeatures() []string {
 	return nil
 }
 
-// ValuationResult represents the result of a property valuation
-type ValuationResult struct {
+func (x *Property) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *Property) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+// Location represents the geographic coordinates of a property
+type Location struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Value         float64                `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
-	Confidence    float64                `protobuf:"fixed64,2,opt,name=confidence,proto3" json:"confidence,omitempty"`
-	Explanation   string                 `protobuf:"bytes,3,opt,name=explanation,proto3" json:"explanation,omitempty"`
-	Issues        []string               `protobuf:"bytes,4,rep,name=issues,proto3" json:"issues,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ValuationResult) Reset() {
-	*x = ValuationResult{}
+func (x *Location) Reset() {
+	*x = Location{}
 	mi := &file_proto_valuation_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ValuationResult) String() string {
+func (x *Location) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValuationResult) ProtoMessage() {}
+func (*Location) ProtoMessage() {}
 
-func (x *ValuationResult) ProtoReflect() protoreflect.Message {
+func (x *Location) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_valuation_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -174,62 +245,118 @@ func (x *ValuationResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValuationResult.ProtoReflect.Descriptor instead.
-func (*ValuationResult) Descriptor() ([]byte, []int) {
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
 	return file_proto_valuation_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *ValuationResult) GetValue() float64 {
+func (x *Location) GetLatitude() float64 {
 	if x != nil {
-		return x.Value
+		return x.Latitude
 	}
 	return 0
 }
 
-func (x *ValuationResult) GetConfidence() float64 {
+func (x *Location) GetLongitude() float64 {
 	if x != nil {
-		return x.Confidence
+		return x.Longitude
 	}
 	return 0
 }
 
-func (x *ValuationResult) GetExplanation() string {
+// Issue represents a finding from validating a property against its claimed condition
+type Issue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Description   string                 `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	Severity      float64                `protobuf:"fixed64,2,opt,name=severity,proto3" json:"severity,omitempty"` // 0.0 to 1.0, where 1.0 is most severe
+	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`   // "age", "feature", "maintenance", "renovation"
+	Field         string                 `protobuf:"bytes,4,opt,name=field,proto3" json:"field,omitempty"`         // Property field the issue relates to
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Issue) Reset() {
+	*x = Issue{}
+	mi := &file_proto_valuation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Issue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Issue) ProtoMessage() {}
+
+func (x *Issue) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[2]
 	if x != nil {
-		return x.Explanation
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Issue.ProtoReflect.Descriptor instead.
+func (*Issue) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Issue) GetDescription() string {
+	if x != nil {
+		return x.Description
 	}
 	return ""
 }
 
-func (x *ValuationResult) GetIssues() []string {
+func (x *Issue) GetSeverity() float64 {
 	if x != nil {
-		return x.Issues
+		return x.Severity
 	}
-	return nil
+	return 0
 }
 
-// ValuationRequest represents a request to value a property
-type ValuationRequest struct {
+func (x *Issue) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Issue) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+// Adjustment represents a validation adjustment factor applied to the condition multiplier
+type Adjustment struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Property      *Property              `protobuf:"bytes,1,opt,name=property,proto3" json:"property,omitempty"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Factor        float64                `protobuf:"fixed64,2,opt,name=factor,proto3" json:"factor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ValuationRequest) Reset() {
-	*x = ValuationRequest{}
-	mi := &file_proto_valuation_proto_msgTypes[2]
+func (x *Adjustment) Reset() {
+	*x = Adjustment{}
+	mi := &file_proto_valuation_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ValuationRequest) String() string {
+func (x *Adjustment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValuationRequest) ProtoMessage() {}
+func (*Adjustment) ProtoMessage() {}
 
-func (x *ValuationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_valuation_proto_msgTypes[2]
+func (x *Adjustment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -240,41 +367,49 @@ func (x *ValuationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValuationRequest.ProtoReflect.Descriptor instead.
-func (*ValuationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_valuation_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use Adjustment.ProtoReflect.Descriptor instead.
+func (*Adjustment) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ValuationRequest) GetProperty() *Property {
+func (x *Adjustment) GetCategory() string {
 	if x != nil {
-		return x.Property
+		return x.Category
 	}
-	return nil
+	return ""
 }
 
-// ValuationResponse represents the response from a valuation request
-type ValuationResponse struct {
+func (x *Adjustment) GetFactor() float64 {
+	if x != nil {
+		return x.Factor
+	}
+	return 0
+}
+
+// FeatureAddition represents the value added by a single property feature
+type FeatureAddition struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Result        *ValuationResult       `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Feature       string                 `protobuf:"bytes,1,opt,name=feature,proto3" json:"feature,omitempty"`
+	Value         float64                `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ValuationResponse) Reset() {
-	*x = ValuationResponse{}
-	mi := &file_proto_valuation_proto_msgTypes[3]
+func (x *FeatureAddition) Reset() {
+	*x = FeatureAddition{}
+	mi := &file_proto_valuation_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ValuationResponse) String() string {
+func (x *FeatureAddition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValuationResponse) ProtoMessage() {}
+func (*FeatureAddition) ProtoMessage() {}
 
-func (x *ValuationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_valuation_proto_msgTypes[3]
+func (x *FeatureAddition) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -285,98 +420,4367 @@ func (x *ValuationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValuationResponse.ProtoReflect.Descriptor instead.
-func (*ValuationResponse) Descriptor() ([]byte, []int) {
-	return file_proto_valuation_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use FeatureAddition.ProtoReflect.Descriptor instead.
+func (*FeatureAddition) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ValuationResponse) GetResult() *ValuationResult {
+func (x *FeatureAddition) GetFeature() string {
 	if x != nil {
-		return x.Result
+		return x.Feature
+	}
+	return ""
+}
+
+func (x *FeatureAddition) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// ValuationBreakdown represents each stage of a property valuation
+type ValuationBreakdown struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	PricePerSquareFoot    float64                `protobuf:"fixed64,1,opt,name=price_per_square_foot,json=pricePerSquareFoot,proto3" json:"price_per_square_foot,omitempty"`
+	BaseValue             float64                `protobuf:"fixed64,2,opt,name=base_value,json=baseValue,proto3" json:"base_value,omitempty"`           // Square footage x price per sq ft
+	LocationClass         string                 `protobuf:"bytes,3,opt,name=location_class,json=locationClass,proto3" json:"location_class,omitempty"` // Empty when the location was not classified
+	LocationMultiplier    float64                `protobuf:"fixed64,4,opt,name=location_multiplier,json=locationMultiplier,proto3" json:"location_multiplier,omitempty"`
+	ConditionDescription  string                 `protobuf:"bytes,5,opt,name=condition_description,json=conditionDescription,proto3" json:"condition_description,omitempty"`
+	ConditionMultiplier   float64                `protobuf:"fixed64,6,opt,name=condition_multiplier,json=conditionMultiplier,proto3" json:"condition_multiplier,omitempty"`
+	ValidationScore       float64                `protobuf:"fixed64,7,opt,name=validation_score,json=validationScore,proto3" json:"validation_score,omitempty"`
+	ValidationAdjustments []*Adjustment          `protobuf:"bytes,8,rep,name=validation_adjustments,json=validationAdjustments,proto3" json:"validation_adjustments,omitempty"`
+	AdjustedMultiplier    float64                `protobuf:"fixed64,9,opt,name=adjusted_multiplier,json=adjustedMultiplier,proto3" json:"adjusted_multiplier,omitempty"` // Condition multiplier x validation score
+	FeatureAdditions      []*FeatureAddition     `protobuf:"bytes,10,rep,name=feature_additions,json=featureAdditions,proto3" json:"feature_additions,omitempty"`
+	FeatureValue          float64                `protobuf:"fixed64,11,opt,name=feature_value,json=featureValue,proto3" json:"feature_value,omitempty"` // Sum of feature additions
+	AgeDepreciation       float64                `protobuf:"fixed64,12,opt,name=age_depreciation,json=ageDepreciation,proto3" json:"age_depreciation,omitempty"`
+	BedroomValue          float64                `protobuf:"fixed64,13,opt,name=bedroom_value,json=bedroomValue,proto3" json:"bedroom_value,omitempty"`
+	BathroomValue         float64                `protobuf:"fixed64,14,opt,name=bathroom_value,json=bathroomValue,proto3" json:"bathroom_value,omitempty"`
+	FinalValue            float64                `protobuf:"fixed64,15,opt,name=final_value,json=finalValue,proto3" json:"final_value,omitempty"`
+	Uncertainty           []*UncertaintySource   `protobuf:"bytes,16,rep,name=uncertainty,proto3" json:"uncertainty,omitempty"`
+	RelativeUncertainty   float64                `protobuf:"fixed64,17,opt,name=relative_uncertainty,json=relativeUncertainty,proto3" json:"relative_uncertainty,omitempty"` // Combined standard deviation as a fraction of the value
+	ValuationDate         *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=valuation_date,json=valuationDate,proto3" json:"valuation_date,omitempty"`
+	PriceIndexRegion      string                 `protobuf:"bytes,19,opt,name=price_index_region,json=priceIndexRegion,proto3" json:"price_index_region,omitempty"`   // Empty when base prices were not indexed
+	PriceIndexFactor      float64                `protobuf:"fixed64,20,opt,name=price_index_factor,json=priceIndexFactor,proto3" json:"price_index_factor,omitempty"` // Index level at the valuation date relative to the price date
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ValuationBreakdown) Reset() {
+	*x = ValuationBreakdown{}
+	mi := &file_proto_valuation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValuationBreakdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValuationBreakdown) ProtoMessage() {}
+
+func (x *ValuationBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValuationBreakdown.ProtoReflect.Descriptor instead.
+func (*ValuationBreakdown) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ValuationBreakdown) GetPricePerSquareFoot() float64 {
+	if x != nil {
+		return x.PricePerSquareFoot
+	}
+	return 0
+}
+
+func (x *ValuationBreakdown) GetBaseValue() float64 {
+	if x != nil {
+		return x.BaseValue
+	}
+	return 0
+}
+
+func (x *ValuationBreakdown) GetLocationClass() string {
+	if x != nil {
+		return x.LocationClass
+	}
+	return ""
+}
+
+func (x *ValuationBreakdown) GetLocationMultiplier() float64 {
+	if x != nil {
+		return x.LocationMultiplier
+	}
+	return 0
+}
+
+func (x *ValuationBreakdown) GetConditionDescription() string {
+	if x != nil {
+		return x.ConditionDescription
+	}
+	return ""
+}
+
+func (x *ValuationBreakdown) GetConditionMultiplier() float64 {
+	if x != nil {
+		return x.ConditionMultiplier
+	}
+	return 0
+}
+
+func (x *ValuationBreakdown) GetValidationScore() float64 {
+	if x != nil {
+		return x.ValidationScore
+	}
+	return 0
+}
+
+func (x *ValuationBreakdown) GetValidationAdjustments() []*Adjustment {
+	if x != nil {
+		return x.ValidationAdjustments
 	}
 	return nil
 }
 
-var File_proto_valuation_proto protoreflect.FileDescriptor
+func (x *ValuationBreakdown) GetAdjustedMultiplier() float64 {
+	if x != nil {
+		return x.AdjustedMultiplier
+	}
+	return 0
+}
 
-const file_proto_valuation_proto_rawDesc = "" +
-	"\n" +
-	"\x15proto/valuation.proto\x12\tvaluation\"\xdd\x02\n" +
-	"\bProperty\x12\x18\n" +
-	"\aaddress\x18\x01 \x01(\tR\aaddress\x12#\n" +
-	"\rproperty_type\x18\x02 \x01(\tR\fpropertyType\x12\x1a\n" +
-	"\bbedrooms\x18\x03 \x01(\x05R\bbedrooms\x12\x1c\n" +
-	"\tbathrooms\x18\x04 \x01(\x05R\tbathrooms\x12%\n" +
-	"\x0esquare_footage\x18\x05 \x01(\x05R\rsquareFootage\x12\x1d\n" +
-	"\n" +
-	"year_built\x18\x06 \x01(\x05R\tyearBuilt\x12\x1c\n" +
-	"\tcondition\x18\a \x01(\tR\tcondition\x12+\n" +
-	"\x11maintenance_level\x18\b \x01(\tR\x10maintenanceLevel\x12+\n" +
-	"\x11renovation_status\x18\t \x01(\tR\x10renovationStatus\x12\x1a\n" +
-	"\bfeatures\x18\n" +
-	" \x03(\tR\bfeatures\"\x81\x01\n" +
-	"\x0fValuationResult\x12\x14\n" +
-	"\x05value\x18\x01 \x01(\x01R\x05value\x12\x1e\n" +
-	"\n" +
-	"confidence\x18\x02 \x01(\x01R\n" +
-	"confidence\x12 \n" +
-	"\vexplanation\x18\x03 \x01(\tR\vexplanation\x12\x16\n" +
-	"\x06issues\x18\x04 \x03(\tR\x06issues\"C\n" +
-	"\x10ValuationRequest\x12/\n" +
-	"\bproperty\x18\x01 \x01(\v2\x13.valuation.PropertyR\bproperty\"G\n" +
-	"\x11ValuationResponse\x122\n" +
-	"\x06result\x18\x01 \x01(\v2\x1a.valuation.ValuationResultR\x06result2e\n" +
-	"\x10ValuationService\x12Q\n" +
-	"\x12CalculateValuation\x12\x1b.valuation.ValuationRequest\x1a\x1c.valuation.ValuationResponse\"\x00B6Z4github.com/jsarcade/property-valuation-service/protob\x06proto3"
+func (x *ValuationBreakdown) GetFeatureAdditions() []*FeatureAddition {
+	if x != nil {
+		return x.FeatureAdditions
+	}
+	return nil
+}
 
-var (
-	file_proto_valuation_proto_rawDescOnce sync.Once
-	file_proto_valuation_proto_rawDescData []byte
-)
+func (x *ValuationBreakdown) GetFeatureValue() float64 {
+	if x != nil {
+		return x.FeatureValue
+	}
+	return 0
+}
 
-func file_proto_valuation_proto_rawDescGZIP() []byte {
-	file_proto_valuation_proto_rawDescOnce.Do(func() {
-		file_proto_valuation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_valuation_proto_rawDesc), len(file_proto_valuation_proto_rawDesc)))
-	})
-	return file_proto_valuation_proto_rawDescData
+func (x *ValuationBreakdown) GetAgeDepreciation() float64 {
+	if x != nil {
+		return x.AgeDepreciation
+	}
+	return 0
 }
 
-var file_proto_valuation_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
-var file_proto_valuation_proto_goTypes = []any{
-	(*Property)(nil),          // 0: valuation.Property
-	(*ValuationResult)(nil),   // 1: valuation.ValuationResult
-	(*ValuationRequest)(nil),  // 2: valuation.ValuationRequest
-	(*ValuationResponse)(nil), // 3: valuation.ValuationResponse
+func (x *ValuationBreakdown) GetBedroomValue() float64 {
+	if x != nil {
+		return x.BedroomValue
+	}
+	return 0
 }
-var file_proto_valuation_proto_depIdxs = []int32{
-	0, // 0: valuation.ValuationRequest.property:type_name -> valuation.Property
-	1, // 1: valuation.ValuationResponse.result:type_name -> valuation.ValuationResult
-	2, // 2: valuation.ValuationService.CalculateValuation:input_type -> valuation.ValuationRequest
-	3, // 3: valuation.ValuationService.CalculateValuation:output_type -> valuation.ValuationResponse
-	3, // [3:4] is the sub-list for method output_type
-	2, // [2:3] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+
+func (x *ValuationBreakdown) GetBathroomValue() float64 {
+	if x != nil {
+		return x.BathroomValue
+	}
+	return 0
 }
 
-func init() { file_proto_valuation_proto_init() }
-func file_proto_valuation_proto_init() {
-	if File_proto_valuation_proto != nil {
+func (x *ValuationBreakdown) GetFinalValue() float64 {
+	if x != nil {
+		return x.FinalValue
+	}
+	return 0
+}
+
+func (x *ValuationBreakdown) GetUncertainty() []*UncertaintySource {
+	if x != nil {
+		return x.Uncertainty
+	}
+	return nil
+}
+
+func (x *ValuationBreakdown) GetRelativeUncertainty() float64 {
+	if x != nil {
+		return x.RelativeUncertainty
+	}
+	return 0
+}
+
+func (x *ValuationBreakdown) GetValuationDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValuationDate
+	}
+	return nil
+}
+
+func (x *ValuationBreakdown) GetPriceIndexRegion() string {
+	if x != nil {
+		return x.PriceIndexRegion
+	}
+	return ""
+}
+
+func (x *ValuationBreakdown) GetPriceIndexFactor() float64 {
+	if x != nil {
+		return x.PriceIndexFactor
+	}
+	return 0
+}
+
+// UncertaintySource represents one contribution to the uncertainty of a value
+type UncertaintySource struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Source              string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`                                                        // "model", "location", "features", "condition"
+	RelativeUncertainty float64                `protobuf:"fixed64,2,opt,name=relative_uncertainty,json=relativeUncertainty,proto3" json:"relative_uncertainty,omitempty"` // Standard deviation as a fraction of the value
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *UncertaintySource) Reset() {
+	*x = UncertaintySource{}
+	mi := &file_proto_valuation_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UncertaintySource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UncertaintySource) ProtoMessage() {}
+
+func (x *UncertaintySource) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UncertaintySource.ProtoReflect.Descriptor instead.
+func (*UncertaintySource) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UncertaintySource) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *UncertaintySource) GetRelativeUncertainty() float64 {
+	if x != nil {
+		return x.RelativeUncertainty
+	}
+	return 0
+}
+
+// ComparableAdjustment represents a dollar adjustment applied to a comparable sale
+type ComparableAdjustment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"` // "size", "condition", "features", "age", "bedrooms", "bathrooms"
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ComparableAdjustment) Reset() {
+	*x = ComparableAdjustment{}
+	mi := &file_proto_valuation_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComparableAdjustment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComparableAdjustment) ProtoMessage() {}
+
+func (x *ComparableAdjustment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComparableAdjustment.ProtoReflect.Descriptor instead.
+func (*ComparableAdjustment) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ComparableAdjustment) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ComparableAdjustment) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// ComparableSale represents a recent sale used in a sales comparison valuation
+type ComparableSale struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	Address         string                  `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	PropertyType    string                  `protobuf:"bytes,2,opt,name=property_type,json=propertyType,proto3" json:"property_type,omitempty"`
+	SquareFootage   int32                   `protobuf:"varint,3,opt,name=square_footage,json=squareFootage,proto3" json:"square_footage,omitempty"`
+	YearBuilt       int32                   `protobuf:"varint,4,opt,name=year_built,json=yearBuilt,proto3" json:"year_built,omitempty"`
+	Condition       string                  `protobuf:"bytes,5,opt,name=condition,proto3" json:"condition,omitempty"`
+	SalePrice       float64                 `protobuf:"fixed64,6,opt,name=sale_price,json=salePrice,proto3" json:"sale_price,omitempty"`
+	SaleDate        *timestamppb.Timestamp  `protobuf:"bytes,7,opt,name=sale_date,json=saleDate,proto3" json:"sale_date,omitempty"`
+	DistanceKm      float64                 `protobuf:"fixed64,8,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`
+	Adjustments     []*ComparableAdjustment `protobuf:"bytes,9,rep,name=adjustments,proto3" json:"adjustments,omitempty"`
+	AdjustedPrice   float64                 `protobuf:"fixed64,10,opt,name=adjusted_price,json=adjustedPrice,proto3" json:"adjusted_price,omitempty"`
+	GrossAdjustment float64                 `protobuf:"fixed64,11,opt,name=gross_adjustment,json=grossAdjustment,proto3" json:"gross_adjustment,omitempty"` // Sum of absolute adjustments as a fraction of the sale price
+	Weight          float64                 `protobuf:"fixed64,12,opt,name=weight,proto3" json:"weight,omitempty"`                                          // Share of the reconciled value, 0.0 to 1.0
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ComparableSale) Reset() {
+	*x = ComparableSale{}
+	mi := &file_proto_valuation_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComparableSale) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComparableSale) ProtoMessage() {}
+
+func (x *ComparableSale) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComparableSale.ProtoReflect.Descriptor instead.
+func (*ComparableSale) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ComparableSale) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ComparableSale) GetPropertyType() string {
+	if x != nil {
+		return x.PropertyType
+	}
+	return ""
+}
+
+func (x *ComparableSale) GetSquareFootage() int32 {
+	if x != nil {
+		return x.SquareFootage
+	}
+	return 0
+}
+
+func (x *ComparableSale) GetYearBuilt() int32 {
+	if x != nil {
+		return x.YearBuilt
+	}
+	return 0
+}
+
+func (x *ComparableSale) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *ComparableSale) GetSalePrice() float64 {
+	if x != nil {
+		return x.SalePrice
+	}
+	return 0
+}
+
+func (x *ComparableSale) GetSaleDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SaleDate
+	}
+	return nil
+}
+
+func (x *ComparableSale) GetDistanceKm() float64 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+func (x *ComparableSale) GetAdjustments() []*ComparableAdjustment {
+	if x != nil {
+		return x.Adjustments
+	}
+	return nil
+}
+
+func (x *ComparableSale) GetAdjustedPrice() float64 {
+	if x != nil {
+		return x.AdjustedPrice
+	}
+	return 0
+}
+
+func (x *ComparableSale) GetGrossAdjustment() float64 {
+	if x != nil {
+		return x.GrossAdjustment
+	}
+	return 0
+}
+
+func (x *ComparableSale) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+// CashFlow represents the projected net operating income of one year of the holding period
+type CashFlow struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Year               int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	NetOperatingIncome float64                `protobuf:"fixed64,2,opt,name=net_operating_income,json=netOperatingIncome,proto3" json:"net_operating_income,omitempty"`
+	PresentValue       float64                `protobuf:"fixed64,3,opt,name=present_value,json=presentValue,proto3" json:"present_value,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CashFlow) Reset() {
+	*x = CashFlow{}
+	mi := &file_proto_valuation_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CashFlow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CashFlow) ProtoMessage() {}
+
+func (x *CashFlow) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CashFlow.ProtoReflect.Descriptor instead.
+func (*CashFlow) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CashFlow) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *CashFlow) GetNetOperatingIncome() float64 {
+	if x != nil {
+		return x.NetOperatingIncome
+	}
+	return 0
+}
+
+func (x *CashFlow) GetPresentValue() float64 {
+	if x != nil {
+		return x.PresentValue
+	}
+	return 0
+}
+
+// IncomeAnalysis represents the outcome of the income capitalization approach
+type IncomeAnalysis struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	PotentialGrossIncome      float64                `protobuf:"fixed64,1,opt,name=potential_gross_income,json=potentialGrossIncome,proto3" json:"potential_gross_income,omitempty"`
+	VacancyLoss               float64                `protobuf:"fixed64,2,opt,name=vacancy_loss,json=vacancyLoss,proto3" json:"vacancy_loss,omitempty"`
+	EffectiveGrossIncome      float64                `protobuf:"fixed64,3,opt,name=effective_gross_income,json=effectiveGrossIncome,proto3" json:"effective_gross_income,omitempty"`
+	OperatingExpenses         float64                `protobuf:"fixed64,4,opt,name=operating_expenses,json=operatingExpenses,proto3" json:"operating_expenses,omitempty"`
+	NetOperatingIncome        float64                `protobuf:"fixed64,5,opt,name=net_operating_income,json=netOperatingIncome,proto3" json:"net_operating_income,omitempty"`
+	CapRate                   float64                `protobuf:"fixed64,6,opt,name=cap_rate,json=capRate,proto3" json:"cap_rate,omitempty"`
+	DirectCapitalizationValue float64                `protobuf:"fixed64,7,opt,name=direct_capitalization_value,json=directCapitalizationValue,proto3" json:"direct_capitalization_value,omitempty"`
+	CashFlows                 []*CashFlow            `protobuf:"bytes,8,rep,name=cash_flows,json=cashFlows,proto3" json:"cash_flows,omitempty"` // Set when a DCF analysis was requested
+	ReversionValue            float64                `protobuf:"fixed64,9,opt,name=reversion_value,json=reversionValue,proto3" json:"reversion_value,omitempty"`
+	PresentReversionValue     float64                `protobuf:"fixed64,10,opt,name=present_reversion_value,json=presentReversionValue,proto3" json:"present_reversion_value,omitempty"`
+	DcfValue                  float64                `protobuf:"fixed64,11,opt,name=dcf_value,json=dcfValue,proto3" json:"dcf_value,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *IncomeAnalysis) Reset() {
+	*x = IncomeAnalysis{}
+	mi := &file_proto_valuation_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncomeAnalysis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncomeAnalysis) ProtoMessage() {}
+
+func (x *IncomeAnalysis) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncomeAnalysis.ProtoReflect.Descriptor instead.
+func (*IncomeAnalysis) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *IncomeAnalysis) GetPotentialGrossIncome() float64 {
+	if x != nil {
+		return x.PotentialGrossIncome
+	}
+	return 0
+}
+
+func (x *IncomeAnalysis) GetVacancyLoss() float64 {
+	if x != nil {
+		return x.VacancyLoss
+	}
+	return 0
+}
+
+func (x *IncomeAnalysis) GetEffectiveGrossIncome() float64 {
+	if x != nil {
+		return x.EffectiveGrossIncome
+	}
+	return 0
+}
+
+func (x *IncomeAnalysis) GetOperatingExpenses() float64 {
+	if x != nil {
+		return x.OperatingExpenses
+	}
+	return 0
+}
+
+func (x *IncomeAnalysis) GetNetOperatingIncome() float64 {
+	if x != nil {
+		return x.NetOperatingIncome
+	}
+	return 0
+}
+
+func (x *IncomeAnalysis) GetCapRate() float64 {
+	if x != nil {
+		return x.CapRate
+	}
+	return 0
+}
+
+func (x *IncomeAnalysis) GetDirectCapitalizationValue() float64 {
+	if x != nil {
+		return x.DirectCapitalizationValue
+	}
+	return 0
+}
+
+func (x *IncomeAnalysis) GetCashFlows() []*CashFlow {
+	if x != nil {
+		return x.CashFlows
+	}
+	return nil
+}
+
+func (x *IncomeAnalysis) GetReversionValue() float64 {
+	if x != nil {
+		return x.ReversionValue
+	}
+	return 0
+}
+
+func (x *IncomeAnalysis) GetPresentReversionValue() float64 {
+	if x != nil {
+		return x.PresentReversionValue
+	}
+	return 0
+}
+
+func (x *IncomeAnalysis) GetDcfValue() float64 {
+	if x != nil {
+		return x.DcfValue
+	}
+	return 0
+}
+
+// ValuationResult represents the result of a property valuation
+type ValuationResult struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Value       float64                `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	Confidence  float64                `protobuf:"fixed64,2,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	Explanation string                 `protobuf:"bytes,3,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	// Deprecated: use validation_issues, which carries severity, category and field
+	//
+	// Deprecated: Marked as deprecated in proto/valuation.proto.
+	Issues           []string             `protobuf:"bytes,4,rep,name=issues,proto3" json:"issues,omitempty"`
+	ValidationIssues []*Issue             `protobuf:"bytes,5,rep,name=validation_issues,json=validationIssues,proto3" json:"validation_issues,omitempty"`
+	Breakdown        *ValuationBreakdown  `protobuf:"bytes,6,opt,name=breakdown,proto3" json:"breakdown,omitempty"`
+	ModelVersion     string               `protobuf:"bytes,7,opt,name=model_version,json=modelVersion,proto3" json:"model_version,omitempty"`  // Version of the pricing model used
+	Comparables      []*ComparableSale    `protobuf:"bytes,8,rep,name=comparables,proto3" json:"comparables,omitempty"`                        // Set by the sales comparison approach
+	Income           *IncomeAnalysis      `protobuf:"bytes,9,opt,name=income,proto3" json:"income,omitempty"`                                  // Set by the income approach
+	Method           ValuationMethod      `protobuf:"varint,10,opt,name=method,proto3,enum=valuation.ValuationMethod" json:"method,omitempty"` // Approach used to value the property
+	Approaches       []*ApproachValue     `protobuf:"bytes,11,rep,name=approaches,proto3" json:"approaches,omitempty"`                         // Set when several approaches are reconciled
+	ValueRange       *ValueRange          `protobuf:"bytes,12,opt,name=value_range,json=valueRange,proto3" json:"value_range,omitempty"`
+	ValuationId      string               `protobuf:"bytes,13,opt,name=valuation_id,json=valuationId,proto3" json:"valuation_id,omitempty"` // ID of the history record; empty when history is disabled
+	Sensitivity      []*SensitivityFactor `protobuf:"bytes,14,rep,name=sensitivity,proto3" json:"sensitivity,omitempty"`                    // Tornado chart dataset, largest swing first
+	TenantId         string               `protobuf:"bytes,15,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`          // Tenant of the caller, whose pricing overrides apply; empty for callers of no tenant
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ValuationResult) Reset() {
+	*x = ValuationResult{}
+	mi := &file_proto_valuation_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValuationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValuationResult) ProtoMessage() {}
+
+func (x *ValuationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValuationResult.ProtoReflect.Descriptor instead.
+func (*ValuationResult) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ValuationResult) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *ValuationResult) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *ValuationResult) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in proto/valuation.proto.
+func (x *ValuationResult) GetIssues() []string {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *ValuationResult) GetValidationIssues() []*Issue {
+	if x != nil {
+		return x.ValidationIssues
+	}
+	return nil
+}
+
+func (x *ValuationResult) GetBreakdown() *ValuationBreakdown {
+	if x != nil {
+		return x.Breakdown
+	}
+	return nil
+}
+
+func (x *ValuationResult) GetModelVersion() string {
+	if x != nil {
+		return x.ModelVersion
+	}
+	return ""
+}
+
+func (x *ValuationResult) GetComparables() []*ComparableSale {
+	if x != nil {
+		return x.Comparables
+	}
+	return nil
+}
+
+func (x *ValuationResult) GetIncome() *IncomeAnalysis {
+	if x != nil {
+		return x.Income
+	}
+	return nil
+}
+
+func (x *ValuationResult) GetMethod() ValuationMethod {
+	if x != nil {
+		return x.Method
+	}
+	return ValuationMethod_VALUATION_METHOD_UNSPECIFIED
+}
+
+func (x *ValuationResult) GetApproaches() []*ApproachValue {
+	if x != nil {
+		return x.Approaches
+	}
+	return nil
+}
+
+func (x *ValuationResult) GetValueRange() *ValueRange {
+	if x != nil {
+		return x.ValueRange
+	}
+	return nil
+}
+
+func (x *ValuationResult) GetValuationId() string {
+	if x != nil {
+		return x.ValuationId
+	}
+	return ""
+}
+
+func (x *ValuationResult) GetSensitivity() []*SensitivityFactor {
+	if x != nil {
+		return x.Sensitivity
+	}
+	return nil
+}
+
+func (x *ValuationResult) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+// SensitivityFactor represents the value at a low and a high setting of one input,
+// with every other input unchanged; one bar of a tornado chart
+type SensitivityFactor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Input         string                 `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`                       // "square_footage", "condition", "year_built", "feature:<name>"
+	LowInput      string                 `protobuf:"bytes,2,opt,name=low_input,json=lowInput,proto3" json:"low_input,omitempty"` // Low setting of the input, e.g. "1800 sq ft"
+	HighInput     string                 `protobuf:"bytes,3,opt,name=high_input,json=highInput,proto3" json:"high_input,omitempty"`
+	LowValue      float64                `protobuf:"fixed64,4,opt,name=low_value,json=lowValue,proto3" json:"low_value,omitempty"` // Value with the input at its low setting
+	HighValue     float64                `protobuf:"fixed64,5,opt,name=high_value,json=highValue,proto3" json:"high_value,omitempty"`
+	Swing         float64                `protobuf:"fixed64,6,opt,name=swing,proto3" json:"swing,omitempty"` // Absolute difference between the two values
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SensitivityFactor) Reset() {
+	*x = SensitivityFactor{}
+	mi := &file_proto_valuation_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SensitivityFactor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SensitivityFactor) ProtoMessage() {}
+
+func (x *SensitivityFactor) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SensitivityFactor.ProtoReflect.Descriptor instead.
+func (*SensitivityFactor) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SensitivityFactor) GetInput() string {
+	if x != nil {
+		return x.Input
+	}
+	return ""
+}
+
+func (x *SensitivityFactor) GetLowInput() string {
+	if x != nil {
+		return x.LowInput
+	}
+	return ""
+}
+
+func (x *SensitivityFactor) GetHighInput() string {
+	if x != nil {
+		return x.HighInput
+	}
+	return ""
+}
+
+func (x *SensitivityFactor) GetLowValue() float64 {
+	if x != nil {
+		return x.LowValue
+	}
+	return 0
+}
+
+func (x *SensitivityFactor) GetHighValue() float64 {
+	if x != nil {
+		return x.HighValue
+	}
+	return 0
+}
+
+func (x *SensitivityFactor) GetSwing() float64 {
+	if x != nil {
+		return x.Swing
+	}
+	return 0
+}
+
+// ApproachValue represents the value indicated by one approach of a reconciled valuation
+type ApproachValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Approach      string                 `protobuf:"bytes,1,opt,name=approach,proto3" json:"approach,omitempty"` // "cost", "sales_comparison", "income"
+	Value         float64                `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	Confidence    float64                `protobuf:"fixed64,3,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	Weight        float64                `protobuf:"fixed64,4,opt,name=weight,proto3" json:"weight,omitempty"` // Share of the reconciled value, 0.0 to 1.0
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`     // Why the approach could not value the property; it then has no weight
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproachValue) Reset() {
+	*x = ApproachValue{}
+	mi := &file_proto_valuation_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproachValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproachValue) ProtoMessage() {}
+
+func (x *ApproachValue) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproachValue.ProtoReflect.Descriptor instead.
+func (*ApproachValue) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ApproachValue) GetApproach() string {
+	if x != nil {
+		return x.Approach
+	}
+	return ""
+}
+
+func (x *ApproachValue) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *ApproachValue) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *ApproachValue) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *ApproachValue) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// Lease represents a single entry of a rent roll
+type Lease struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenant        string                 `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	SquareFootage int32                  `protobuf:"varint,2,opt,name=square_footage,json=squareFootage,proto3" json:"square_footage,omitempty"`
+	AnnualRent    float64                `protobuf:"fixed64,3,opt,name=annual_rent,json=annualRent,proto3" json:"annual_rent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Lease) Reset() {
+	*x = Lease{}
+	mi := &file_proto_valuation_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Lease) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lease) ProtoMessage() {}
+
+func (x *Lease) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Lease.ProtoReflect.Descriptor instead.
+func (*Lease) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Lease) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *Lease) GetSquareFootage() int32 {
+	if x != nil {
+		return x.SquareFootage
+	}
+	return 0
+}
+
+func (x *Lease) GetAnnualRent() float64 {
+	if x != nil {
+		return x.AnnualRent
+	}
+	return 0
+}
+
+// DiscountedCashFlowOptions represents the assumptions of a discounted cash flow analysis
+type DiscountedCashFlowOptions struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	HoldingPeriodYears int32                  `protobuf:"varint,1,opt,name=holding_period_years,json=holdingPeriodYears,proto3" json:"holding_period_years,omitempty"`
+	DiscountRate       float64                `protobuf:"fixed64,2,opt,name=discount_rate,json=discountRate,proto3" json:"discount_rate,omitempty"`
+	RentGrowthRate     float64                `protobuf:"fixed64,3,opt,name=rent_growth_rate,json=rentGrowthRate,proto3" json:"rent_growth_rate,omitempty"`
+	ExpenseGrowthRate  float64                `protobuf:"fixed64,4,opt,name=expense_growth_rate,json=expenseGrowthRate,proto3" json:"expense_growth_rate,omitempty"`
+	TerminalCapRate    float64                `protobuf:"fixed64,5,opt,name=terminal_cap_rate,json=terminalCapRate,proto3" json:"terminal_cap_rate,omitempty"` // Defaults to the market cap rate when zero
+	SellingCostRate    float64                `protobuf:"fixed64,6,opt,name=selling_cost_rate,json=sellingCostRate,proto3" json:"selling_cost_rate,omitempty"` // Fraction of the sale price paid at reversion
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DiscountedCashFlowOptions) Reset() {
+	*x = DiscountedCashFlowOptions{}
+	mi := &file_proto_valuation_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscountedCashFlowOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscountedCashFlowOptions) ProtoMessage() {}
+
+func (x *DiscountedCashFlowOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscountedCashFlowOptions.ProtoReflect.Descriptor instead.
+func (*DiscountedCashFlowOptions) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DiscountedCashFlowOptions) GetHoldingPeriodYears() int32 {
+	if x != nil {
+		return x.HoldingPeriodYears
+	}
+	return 0
+}
+
+func (x *DiscountedCashFlowOptions) GetDiscountRate() float64 {
+	if x != nil {
+		return x.DiscountRate
+	}
+	return 0
+}
+
+func (x *DiscountedCashFlowOptions) GetRentGrowthRate() float64 {
+	if x != nil {
+		return x.RentGrowthRate
+	}
+	return 0
+}
+
+func (x *DiscountedCashFlowOptions) GetExpenseGrowthRate() float64 {
+	if x != nil {
+		return x.ExpenseGrowthRate
+	}
+	return 0
+}
+
+func (x *DiscountedCashFlowOptions) GetTerminalCapRate() float64 {
+	if x != nil {
+		return x.TerminalCapRate
+	}
+	return 0
+}
+
+func (x *DiscountedCashFlowOptions) GetSellingCostRate() float64 {
+	if x != nil {
+		return x.SellingCostRate
+	}
+	return 0
+}
+
+// IncomeData represents the income and expense information of a commercial property
+type IncomeData struct {
+	state             protoimpl.MessageState     `protogen:"open.v1"`
+	RentRoll          []*Lease                   `protobuf:"bytes,1,rep,name=rent_roll,json=rentRoll,proto3" json:"rent_roll,omitempty"`
+	OtherIncome       float64                    `protobuf:"fixed64,2,opt,name=other_income,json=otherIncome,proto3" json:"other_income,omitempty"`                   // Annual parking, signage and other income
+	VacancyRate       float64                    `protobuf:"fixed64,3,opt,name=vacancy_rate,json=vacancyRate,proto3" json:"vacancy_rate,omitempty"`                   // Vacancy and credit loss, 0.0 to 1.0
+	OperatingExpenses float64                    `protobuf:"fixed64,4,opt,name=operating_expenses,json=operatingExpenses,proto3" json:"operating_expenses,omitempty"` // Annual operating expenses
+	CapRate           float64                    `protobuf:"fixed64,5,opt,name=cap_rate,json=capRate,proto3" json:"cap_rate,omitempty"`                               // Market capitalization rate
+	Dcf               *DiscountedCashFlowOptions `protobuf:"bytes,6,opt,name=dcf,proto3" json:"dcf,omitempty"`                                                        // Optional discounted cash flow analysis
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *IncomeData) Reset() {
+	*x = IncomeData{}
+	mi := &file_proto_valuation_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncomeData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncomeData) ProtoMessage() {}
+
+func (x *IncomeData) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncomeData.ProtoReflect.Descriptor instead.
+func (*IncomeData) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *IncomeData) GetRentRoll() []*Lease {
+	if x != nil {
+		return x.RentRoll
+	}
+	return nil
+}
+
+func (x *IncomeData) GetOtherIncome() float64 {
+	if x != nil {
+		return x.OtherIncome
+	}
+	return 0
+}
+
+func (x *IncomeData) GetVacancyRate() float64 {
+	if x != nil {
+		return x.VacancyRate
+	}
+	return 0
+}
+
+func (x *IncomeData) GetOperatingExpenses() float64 {
+	if x != nil {
+		return x.OperatingExpenses
+	}
+	return 0
+}
+
+func (x *IncomeData) GetCapRate() float64 {
+	if x != nil {
+		return x.CapRate
+	}
+	return 0
+}
+
+func (x *IncomeData) GetDcf() *DiscountedCashFlowOptions {
+	if x != nil {
+		return x.Dcf
+	}
+	return nil
+}
+
+// ValuationRequest represents a request to value a property
+type ValuationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Property      *Property              `protobuf:"bytes,1,opt,name=property,proto3" json:"property,omitempty"`
+	RequestId     string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"` // Optional caller-assigned ID echoed in batch and stream items
+	Method        ValuationMethod        `protobuf:"varint,3,opt,name=method,proto3,enum=valuation.ValuationMethod" json:"method,omitempty"`
+	Income        *IncomeData            `protobuf:"bytes,4,opt,name=income,proto3" json:"income,omitempty"` // Required by the income approach
+	Interval      *IntervalOptions       `protobuf:"bytes,5,opt,name=interval,proto3" json:"interval,omitempty"`
+	Sensitivity   bool                   `protobuf:"varint,6,opt,name=sensitivity,proto3" json:"sensitivity,omitempty"`                         // Compute the impact of each input on the value (cost approach only)
+	ValuationDate *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=valuation_date,json=valuationDate,proto3" json:"valuation_date,omitempty"` // Date to value the property at; defaults to now
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValuationRequest) Reset() {
+	*x = ValuationRequest{}
+	mi := &file_proto_valuation_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValuationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValuationRequest) ProtoMessage() {}
+
+func (x *ValuationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValuationRequest.ProtoReflect.Descriptor instead.
+func (*ValuationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ValuationRequest) GetProperty() *Property {
+	if x != nil {
+		return x.Property
+	}
+	return nil
+}
+
+func (x *ValuationRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ValuationRequest) GetMethod() ValuationMethod {
+	if x != nil {
+		return x.Method
+	}
+	return ValuationMethod_VALUATION_METHOD_UNSPECIFIED
+}
+
+func (x *ValuationRequest) GetIncome() *IncomeData {
+	if x != nil {
+		return x.Income
+	}
+	return nil
+}
+
+func (x *ValuationRequest) GetInterval() *IntervalOptions {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *ValuationRequest) GetSensitivity() bool {
+	if x != nil {
+		return x.Sensitivity
+	}
+	return false
+}
+
+func (x *ValuationRequest) GetValuationDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValuationDate
+	}
+	return nil
+}
+
+// IntervalOptions controls how the value range of a valuation is derived
+type IntervalOptions struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	ConfidenceLevel        float64                `protobuf:"fixed64,1,opt,name=confidence_level,json=confidenceLevel,proto3" json:"confidence_level,omitempty"`                        // Defaults to 0.9
+	MonteCarlo             bool                   `protobuf:"varint,2,opt,name=monte_carlo,json=monteCarlo,proto3" json:"monte_carlo,omitempty"`                                        // Simulate uncertain inputs (cost approach only)
+	Simulations            int32                  `protobuf:"varint,3,opt,name=simulations,proto3" json:"simulations,omitempty"`                                                        // Defaults to 1000
+	SquareFootageTolerance float64                `protobuf:"fixed64,4,opt,name=square_footage_tolerance,json=squareFootageTolerance,proto3" json:"square_footage_tolerance,omitempty"` // Relative standard deviation, defaults to 0.05
+	ConditionUncertainty   float64                `protobuf:"fixed64,5,opt,name=condition_uncertainty,json=conditionUncertainty,proto3" json:"condition_uncertainty,omitempty"`         // Probability the condition is one level off, defaults to 0.3
+	Seed                   uint64                 `protobuf:"varint,6,opt,name=seed,proto3" json:"seed,omitempty"`                                                                      // Makes a simulation reproducible; random when 0
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *IntervalOptions) Reset() {
+	*x = IntervalOptions{}
+	mi := &file_proto_valuation_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntervalOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntervalOptions) ProtoMessage() {}
+
+func (x *IntervalOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntervalOptions.ProtoReflect.Descriptor instead.
+func (*IntervalOptions) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *IntervalOptions) GetConfidenceLevel() float64 {
+	if x != nil {
+		return x.ConfidenceLevel
+	}
+	return 0
+}
+
+func (x *IntervalOptions) GetMonteCarlo() bool {
+	if x != nil {
+		return x.MonteCarlo
+	}
+	return false
+}
+
+func (x *IntervalOptions) GetSimulations() int32 {
+	if x != nil {
+		return x.Simulations
+	}
+	return 0
+}
+
+func (x *IntervalOptions) GetSquareFootageTolerance() float64 {
+	if x != nil {
+		return x.SquareFootageTolerance
+	}
+	return 0
+}
+
+func (x *IntervalOptions) GetConditionUncertainty() float64 {
+	if x != nil {
+		return x.ConditionUncertainty
+	}
+	return 0
+}
+
+func (x *IntervalOptions) GetSeed() uint64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+// Percentile represents the value at a percentile of a simulated distribution
+type Percentile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Percentile    float64                `protobuf:"fixed64,1,opt,name=percentile,proto3" json:"percentile,omitempty"` // 0 to 100
+	Value         float64                `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Percentile) Reset() {
+	*x = Percentile{}
+	mi := &file_proto_valuation_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Percentile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Percentile) ProtoMessage() {}
+
+func (x *Percentile) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Percentile.ProtoReflect.Descriptor instead.
+func (*Percentile) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Percentile) GetPercentile() float64 {
+	if x != nil {
+		return x.Percentile
+	}
+	return 0
+}
+
+func (x *Percentile) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// ValueRange represents the range a value lies within at a confidence level
+type ValueRange struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Low             float64                `protobuf:"fixed64,1,opt,name=low,proto3" json:"low,omitempty"`
+	High            float64                `protobuf:"fixed64,2,opt,name=high,proto3" json:"high,omitempty"`
+	ConfidenceLevel float64                `protobuf:"fixed64,3,opt,name=confidence_level,json=confidenceLevel,proto3" json:"confidence_level,omitempty"`
+	Method          string                 `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`           // "analytical" or "monte_carlo"
+	Percentiles     []*Percentile          `protobuf:"bytes,5,rep,name=percentiles,proto3" json:"percentiles,omitempty"` // Set by Monte Carlo simulations
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ValueRange) Reset() {
+	*x = ValueRange{}
+	mi := &file_proto_valuation_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValueRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValueRange) ProtoMessage() {}
+
+func (x *ValueRange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValueRange.ProtoReflect.Descriptor instead.
+func (*ValueRange) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ValueRange) GetLow() float64 {
+	if x != nil {
+		return x.Low
+	}
+	return 0
+}
+
+func (x *ValueRange) GetHigh() float64 {
+	if x != nil {
+		return x.High
+	}
+	return 0
+}
+
+func (x *ValueRange) GetConfidenceLevel() float64 {
+	if x != nil {
+		return x.ConfidenceLevel
+	}
+	return 0
+}
+
+func (x *ValueRange) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *ValueRange) GetPercentiles() []*Percentile {
+	if x != nil {
+		return x.Percentiles
+	}
+	return nil
+}
+
+// ValuationResponse represents the response from a valuation request
+type ValuationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *ValuationResult       `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValuationResponse) Reset() {
+	*x = ValuationResponse{}
+	mi := &file_proto_valuation_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValuationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValuationResponse) ProtoMessage() {}
+
+func (x *ValuationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValuationResponse.ProtoReflect.Descriptor instead.
+func (*ValuationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ValuationResponse) GetResult() *ValuationResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// FieldViolation describes a single invalid field of a property
+type FieldViolation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldViolation) Reset() {
+	*x = FieldViolation{}
+	mi := &file_proto_valuation_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldViolation) ProtoMessage() {}
+
+func (x *FieldViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldViolation.ProtoReflect.Descriptor instead.
+func (*FieldViolation) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *FieldViolation) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldViolation) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// ValuationError represents the failure to value a single item of a batch or stream
+type ValuationError struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Code            int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"` // gRPC status code
+	Message         string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	FieldViolations []*FieldViolation      `protobuf:"bytes,3,rep,name=field_violations,json=fieldViolations,proto3" json:"field_violations,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ValuationError) Reset() {
+	*x = ValuationError{}
+	mi := &file_proto_valuation_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValuationError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValuationError) ProtoMessage() {}
+
+func (x *ValuationError) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValuationError.ProtoReflect.Descriptor instead.
+func (*ValuationError) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ValuationError) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ValuationError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ValuationError) GetFieldViolations() []*FieldViolation {
+	if x != nil {
+		return x.FieldViolations
+	}
+	return nil
+}
+
+// ValuationItem represents the outcome of valuing a single item of a batch or stream
+type ValuationItem struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Index     int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`                         // Position of the request in the batch or stream
+	RequestId string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"` // Echoed from the request
+	// Types that are valid to be assigned to Outcome:
+	//
+	//	*ValuationItem_Result
+	//	*ValuationItem_Error
+	Outcome       isValuationItem_Outcome `protobuf_oneof:"outcome"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValuationItem) Reset() {
+	*x = ValuationItem{}
+	mi := &file_proto_valuation_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValuationItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValuationItem) ProtoMessage() {}
+
+func (x *ValuationItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValuationItem.ProtoReflect.Descriptor instead.
+func (*ValuationItem) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ValuationItem) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ValuationItem) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *ValuationItem) GetOutcome() isValuationItem_Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return nil
+}
+
+func (x *ValuationItem) GetResult() *ValuationResult {
+	if x != nil {
+		if x, ok := x.Outcome.(*ValuationItem_Result); ok {
+			return x.Result
+		}
+	}
+	return nil
+}
+
+func (x *ValuationItem) GetError() *ValuationError {
+	if x != nil {
+		if x, ok := x.Outcome.(*ValuationItem_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+type isValuationItem_Outcome interface {
+	isValuationItem_Outcome()
+}
+
+type ValuationItem_Result struct {
+	Result *ValuationResult `protobuf:"bytes,3,opt,name=result,proto3,oneof"`
+}
+
+type ValuationItem_Error struct {
+	Error *ValuationError `protobuf:"bytes,4,opt,name=error,proto3,oneof"`
+}
+
+func (*ValuationItem_Result) isValuationItem_Outcome() {}
+
+func (*ValuationItem_Error) isValuationItem_Outcome() {}
+
+// BatchValuationRequest represents a request to value several properties at once
+type BatchValuationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Requests      []*ValuationRequest    `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchValuationRequest) Reset() {
+	*x = BatchValuationRequest{}
+	mi := &file_proto_valuation_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchValuationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchValuationRequest) ProtoMessage() {}
+
+func (x *BatchValuationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchValuationRequest.ProtoReflect.Descriptor instead.
+func (*BatchValuationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *BatchValuationRequest) GetRequests() []*ValuationRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+// BatchValuationResponse represents the outcome of every item of a batch, in request order
+type BatchValuationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ValuationItem       `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchValuationResponse) Reset() {
+	*x = BatchValuationResponse{}
+	mi := &file_proto_valuation_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchValuationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchValuationResponse) ProtoMessage() {}
+
+func (x *BatchValuationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchValuationResponse.ProtoReflect.Descriptor instead.
+func (*BatchValuationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *BatchValuationResponse) GetItems() []*ValuationItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// ValuationService provides methods for property valuation
+// ValuationRecord represents a valuation stored in the history
+type ValuationRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ModelVersion  string                 `protobuf:"bytes,4,opt,name=model_version,json=modelVersion,proto3" json:"model_version,omitempty"`
+	Request       *ValuationRequest      `protobuf:"bytes,5,opt,name=request,proto3" json:"request,omitempty"`
+	Result        *ValuationResult       `protobuf:"bytes,6,opt,name=result,proto3" json:"result,omitempty"`
+	TenantId      string                 `protobuf:"bytes,7,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"` // Tenant the property was valued for; empty for callers of no tenant
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValuationRecord) Reset() {
+	*x = ValuationRecord{}
+	mi := &file_proto_valuation_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValuationRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValuationRecord) ProtoMessage() {}
+
+func (x *ValuationRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValuationRecord.ProtoReflect.Descriptor instead.
+func (*ValuationRecord) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ValuationRecord) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ValuationRecord) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ValuationRecord) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ValuationRecord) GetModelVersion() string {
+	if x != nil {
+		return x.ModelVersion
+	}
+	return ""
+}
+
+func (x *ValuationRecord) GetRequest() *ValuationRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *ValuationRecord) GetResult() *ValuationResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *ValuationRecord) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+// GetValuationRequest represents a request for a stored valuation
+type GetValuationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetValuationRequest) Reset() {
+	*x = GetValuationRequest{}
+	mi := &file_proto_valuation_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetValuationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetValuationRequest) ProtoMessage() {}
+
+func (x *GetValuationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetValuationRequest.ProtoReflect.Descriptor instead.
+func (*GetValuationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetValuationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// ListValuationsRequest represents a request for the valuations of an address within a time range
+type ListValuationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"` // Inclusive; unbounded when unset
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`       // Exclusive; unbounded when unset
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`   // Defaults to 100, at most 1000
+	PageToken     string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token of the previous page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListValuationsRequest) Reset() {
+	*x = ListValuationsRequest{}
+	mi := &file_proto_valuation_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListValuationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListValuationsRequest) ProtoMessage() {}
+
+func (x *ListValuationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListValuationsRequest.ProtoReflect.Descriptor instead.
+func (*ListValuationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListValuationsRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ListValuationsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ListValuationsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ListValuationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListValuationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListValuationsResponse represents a page of stored valuations, oldest first
+type ListValuationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valuations    []*ValuationRecord     `protobuf:"bytes,1,rep,name=valuations,proto3" json:"valuations,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListValuationsResponse) Reset() {
+	*x = ListValuationsResponse{}
+	mi := &file_proto_valuation_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListValuationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListValuationsResponse) ProtoMessage() {}
+
+func (x *ListValuationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListValuationsResponse.ProtoReflect.Descriptor instead.
+func (*ListValuationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListValuationsResponse) GetValuations() []*ValuationRecord {
+	if x != nil {
+		return x.Valuations
+	}
+	return nil
+}
+
+func (x *ListValuationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// GetValuationAsOfRequest represents a request for the latest valuation of an address at a point in time
+type GetValuationAsOfRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	AsOf          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"` // Defaults to now
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetValuationAsOfRequest) Reset() {
+	*x = GetValuationAsOfRequest{}
+	mi := &file_proto_valuation_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetValuationAsOfRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetValuationAsOfRequest) ProtoMessage() {}
+
+func (x *GetValuationAsOfRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetValuationAsOfRequest.ProtoReflect.Descriptor instead.
+func (*GetValuationAsOfRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetValuationAsOfRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GetValuationAsOfRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+// Modification represents a single change to a property in a what-if scenario
+type Modification struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Change:
+	//
+	//	*Modification_AddFeature
+	//	*Modification_RemoveFeature
+	//	*Modification_Condition
+	//	*Modification_MaintenanceLevel
+	//	*Modification_RenovationStatus
+	//	*Modification_AddSquareFootage
+	Change        isModification_Change `protobuf_oneof:"change"`
+	Cost          float64               `protobuf:"fixed64,7,opt,name=cost,proto3" json:"cost,omitempty"` // Cost of making the change
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Modification) Reset() {
+	*x = Modification{}
+	mi := &file_proto_valuation_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Modification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Modification) ProtoMessage() {}
+
+func (x *Modification) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Modification.ProtoReflect.Descriptor instead.
+func (*Modification) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Modification) GetChange() isModification_Change {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+func (x *Modification) GetAddFeature() string {
+	if x != nil {
+		if x, ok := x.Change.(*Modification_AddFeature); ok {
+			return x.AddFeature
+		}
+	}
+	return ""
+}
+
+func (x *Modification) GetRemoveFeature() string {
+	if x != nil {
+		if x, ok := x.Change.(*Modification_RemoveFeature); ok {
+			return x.RemoveFeature
+		}
+	}
+	return ""
+}
+
+func (x *Modification) GetCondition() string {
+	if x != nil {
+		if x, ok := x.Change.(*Modification_Condition); ok {
+			return x.Condition
+		}
+	}
+	return ""
+}
+
+func (x *Modification) GetMaintenanceLevel() string {
+	if x != nil {
+		if x, ok := x.Change.(*Modification_MaintenanceLevel); ok {
+			return x.MaintenanceLevel
+		}
+	}
+	return ""
+}
+
+func (x *Modification) GetRenovationStatus() string {
+	if x != nil {
+		if x, ok := x.Change.(*Modification_RenovationStatus); ok {
+			return x.RenovationStatus
+		}
+	}
+	return ""
+}
+
+func (x *Modification) GetAddSquareFootage() int32 {
+	if x != nil {
+		if x, ok := x.Change.(*Modification_AddSquareFootage); ok {
+			return x.AddSquareFootage
+		}
+	}
+	return 0
+}
+
+func (x *Modification) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+type isModification_Change interface {
+	isModification_Change()
+}
+
+type Modification_AddFeature struct {
+	AddFeature string `protobuf:"bytes,1,opt,name=add_feature,json=addFeature,proto3,oneof"` // Must be a known feature the property lacks
+}
+
+type Modification_RemoveFeature struct {
+	RemoveFeature string `protobuf:"bytes,2,opt,name=remove_feature,json=removeFeature,proto3,oneof"` // Must be a feature the property has
+}
+
+type Modification_Condition struct {
+	Condition string `protobuf:"bytes,3,opt,name=condition,proto3,oneof"`
+}
+
+type Modification_MaintenanceLevel struct {
+	MaintenanceLevel string `protobuf:"bytes,4,opt,name=maintenance_level,json=maintenanceLevel,proto3,oneof"`
+}
+
+type Modification_RenovationStatus struct {
+	RenovationStatus string `protobuf:"bytes,5,opt,name=renovation_status,json=renovationStatus,proto3,oneof"`
+}
+
+type Modification_AddSquareFootage struct {
+	AddSquareFootage int32 `protobuf:"varint,6,opt,name=add_square_footage,json=addSquareFootage,proto3,oneof"` // May be negative to remove space
+}
+
+func (*Modification_AddFeature) isModification_Change() {}
+
+func (*Modification_RemoveFeature) isModification_Change() {}
+
+func (*Modification_Condition) isModification_Change() {}
+
+func (*Modification_MaintenanceLevel) isModification_Change() {}
+
+func (*Modification_RenovationStatus) isModification_Change() {}
+
+func (*Modification_AddSquareFootage) isModification_Change() {}
+
+// Scenario represents a named set of modifications applied together, in order
+type Scenario struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Modifications []*Modification        `protobuf:"bytes,2,rep,name=modifications,proto3" json:"modifications,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Scenario) Reset() {
+	*x = Scenario{}
+	mi := &file_proto_valuation_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Scenario) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Scenario) ProtoMessage() {}
+
+func (x *Scenario) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Scenario.ProtoReflect.Descriptor instead.
+func (*Scenario) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Scenario) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Scenario) GetModifications() []*Modification {
+	if x != nil {
+		return x.Modifications
+	}
+	return nil
+}
+
+// ScenarioRequest represents a request to value what-if scenarios for a property
+type ScenarioRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Property      *Property              `protobuf:"bytes,1,opt,name=property,proto3" json:"property,omitempty"`
+	Scenarios     []*Scenario            `protobuf:"bytes,2,rep,name=scenarios,proto3" json:"scenarios,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScenarioRequest) Reset() {
+	*x = ScenarioRequest{}
+	mi := &file_proto_valuation_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScenarioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScenarioRequest) ProtoMessage() {}
+
+func (x *ScenarioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScenarioRequest.ProtoReflect.Descriptor instead.
+func (*ScenarioRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ScenarioRequest) GetProperty() *Property {
+	if x != nil {
+		return x.Property
+	}
+	return nil
+}
+
+func (x *ScenarioRequest) GetScenarios() []*Scenario {
+	if x != nil {
+		return x.Scenarios
+	}
+	return nil
+}
+
+// ModificationImpact represents the value a modification adds on top of the ones before it
+type ModificationImpact struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Description   string                 `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	Cost          float64                `protobuf:"fixed64,2,opt,name=cost,proto3" json:"cost,omitempty"`
+	ValueDelta    float64                `protobuf:"fixed64,3,opt,name=value_delta,json=valueDelta,proto3" json:"value_delta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModificationImpact) Reset() {
+	*x = ModificationImpact{}
+	mi := &file_proto_valuation_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModificationImpact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModificationImpact) ProtoMessage() {}
+
+func (x *ModificationImpact) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModificationImpact.ProtoReflect.Descriptor instead.
+func (*ModificationImpact) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ModificationImpact) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ModificationImpact) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *ModificationImpact) GetValueDelta() float64 {
+	if x != nil {
+		return x.ValueDelta
+	}
+	return 0
+}
+
+// ScenarioResult represents the outcome of a what-if scenario
+type ScenarioResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Property      *Property              `protobuf:"bytes,2,opt,name=property,proto3" json:"property,omitempty"` // Property with every modification applied
+	Value         float64                `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+	ValueDelta    float64                `protobuf:"fixed64,4,opt,name=value_delta,json=valueDelta,proto3" json:"value_delta,omitempty"` // Value minus the base value
+	Cost          float64                `protobuf:"fixed64,5,opt,name=cost,proto3" json:"cost,omitempty"`                               // Sum of the modification costs
+	Roi           float64                `protobuf:"fixed64,6,opt,name=roi,proto3" json:"roi,omitempty"`                                 // (value_delta - cost) / cost; zero when the scenario costs nothing
+	Impacts       []*ModificationImpact  `protobuf:"bytes,7,rep,name=impacts,proto3" json:"impacts,omitempty"`
+	Breakdown     *ValuationBreakdown    `protobuf:"bytes,8,opt,name=breakdown,proto3" json:"breakdown,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScenarioResult) Reset() {
+	*x = ScenarioResult{}
+	mi := &file_proto_valuation_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScenarioResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScenarioResult) ProtoMessage() {}
+
+func (x *ScenarioResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScenarioResult.ProtoReflect.Descriptor instead.
+func (*ScenarioResult) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ScenarioResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ScenarioResult) GetProperty() *Property {
+	if x != nil {
+		return x.Property
+	}
+	return nil
+}
+
+func (x *ScenarioResult) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *ScenarioResult) GetValueDelta() float64 {
+	if x != nil {
+		return x.ValueDelta
+	}
+	return 0
+}
+
+func (x *ScenarioResult) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *ScenarioResult) GetRoi() float64 {
+	if x != nil {
+		return x.Roi
+	}
+	return 0
+}
+
+func (x *ScenarioResult) GetImpacts() []*ModificationImpact {
+	if x != nil {
+		return x.Impacts
+	}
+	return nil
+}
+
+func (x *ScenarioResult) GetBreakdown() *ValuationBreakdown {
+	if x != nil {
+		return x.Breakdown
+	}
+	return nil
+}
+
+// ScenarioResponse represents the base valuation of a property and the outcome of each scenario
+type ScenarioResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BaseValue     float64                `protobuf:"fixed64,1,opt,name=base_value,json=baseValue,proto3" json:"base_value,omitempty"`
+	BaseBreakdown *ValuationBreakdown    `protobuf:"bytes,2,opt,name=base_breakdown,json=baseBreakdown,proto3" json:"base_breakdown,omitempty"`
+	Scenarios     []*ScenarioResult      `protobuf:"bytes,3,rep,name=scenarios,proto3" json:"scenarios,omitempty"`
+	ModelVersion  string                 `protobuf:"bytes,4,opt,name=model_version,json=modelVersion,proto3" json:"model_version,omitempty"`
+	TenantId      string                 `protobuf:"bytes,5,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"` // Tenant of the caller, whose pricing overrides apply
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScenarioResponse) Reset() {
+	*x = ScenarioResponse{}
+	mi := &file_proto_valuation_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScenarioResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScenarioResponse) ProtoMessage() {}
+
+func (x *ScenarioResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScenarioResponse.ProtoReflect.Descriptor instead.
+func (*ScenarioResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ScenarioResponse) GetBaseValue() float64 {
+	if x != nil {
+		return x.BaseValue
+	}
+	return 0
+}
+
+func (x *ScenarioResponse) GetBaseBreakdown() *ValuationBreakdown {
+	if x != nil {
+		return x.BaseBreakdown
+	}
+	return nil
+}
+
+func (x *ScenarioResponse) GetScenarios() []*ScenarioResult {
+	if x != nil {
+		return x.Scenarios
+	}
+	return nil
+}
+
+func (x *ScenarioResponse) GetModelVersion() string {
+	if x != nil {
+		return x.ModelVersion
+	}
+	return ""
+}
+
+func (x *ScenarioResponse) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+// ListPropertyTypesRequest represents a request for the property types of the active pricing model
+type ListPropertyTypesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPropertyTypesRequest) Reset() {
+	*x = ListPropertyTypesRequest{}
+	mi := &file_proto_valuation_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPropertyTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPropertyTypesRequest) ProtoMessage() {}
+
+func (x *ListPropertyTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPropertyTypesRequest.ProtoReflect.Descriptor instead.
+func (*ListPropertyTypesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{38}
+}
+
+// PropertyType represents a property type that can be valued
+type PropertyType struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	PricePerSquareFoot float64                `protobuf:"fixed64,2,opt,name=price_per_square_foot,json=pricePerSquareFoot,proto3" json:"price_per_square_foot,omitempty"`
+	IncomeApproach     bool                   `protobuf:"varint,3,opt,name=income_approach,json=incomeApproach,proto3" json:"income_approach,omitempty"` // Whether the income approach applies to the type
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *PropertyType) Reset() {
+	*x = PropertyType{}
+	mi := &file_proto_valuation_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PropertyType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PropertyType) ProtoMessage() {}
+
+func (x *PropertyType) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PropertyType.ProtoReflect.Descriptor instead.
+func (*PropertyType) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PropertyType) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PropertyType) GetPricePerSquareFoot() float64 {
+	if x != nil {
+		return x.PricePerSquareFoot
+	}
+	return 0
+}
+
+func (x *PropertyType) GetIncomeApproach() bool {
+	if x != nil {
+		return x.IncomeApproach
+	}
+	return false
+}
+
+// ListPropertyTypesResponse represents the property types of a pricing model, sorted by name
+type ListPropertyTypesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PropertyTypes []*PropertyType        `protobuf:"bytes,1,rep,name=property_types,json=propertyTypes,proto3" json:"property_types,omitempty"`
+	ModelVersion  string                 `protobuf:"bytes,2,opt,name=model_version,json=modelVersion,proto3" json:"model_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPropertyTypesResponse) Reset() {
+	*x = ListPropertyTypesResponse{}
+	mi := &file_proto_valuation_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPropertyTypesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPropertyTypesResponse) ProtoMessage() {}
+
+func (x *ListPropertyTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPropertyTypesResponse.ProtoReflect.Descriptor instead.
+func (*ListPropertyTypesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListPropertyTypesResponse) GetPropertyTypes() []*PropertyType {
+	if x != nil {
+		return x.PropertyTypes
+	}
+	return nil
+}
+
+func (x *ListPropertyTypesResponse) GetModelVersion() string {
+	if x != nil {
+		return x.ModelVersion
+	}
+	return ""
+}
+
+// ListConditionsRequest represents a request for the conditions of the active pricing model
+type ListConditionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConditionsRequest) Reset() {
+	*x = ListConditionsRequest{}
+	mi := &file_proto_valuation_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConditionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConditionsRequest) ProtoMessage() {}
+
+func (x *ListConditionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConditionsRequest.ProtoReflect.Descriptor instead.
+func (*ListConditionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{41}
+}
+
+// Condition represents a property condition and the criteria a property must meet for it
+type Condition struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Multiplier       float64                `protobuf:"fixed64,2,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	Description      string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Criteria         []string               `protobuf:"bytes,4,rep,name=criteria,proto3" json:"criteria,omitempty"`
+	MinAge           int32                  `protobuf:"varint,5,opt,name=min_age,json=minAge,proto3" json:"min_age,omitempty"` // Minimum age in years
+	MaxAge           int32                  `protobuf:"varint,6,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"` // Maximum age in years; no limit when zero
+	RequiredFeatures []string               `protobuf:"bytes,7,rep,name=required_features,json=requiredFeatures,proto3" json:"required_features,omitempty"`
+	ExcludedFeatures []string               `protobuf:"bytes,8,rep,name=excluded_features,json=excludedFeatures,proto3" json:"excluded_features,omitempty"`
+	MaintenanceLevel string                 `protobuf:"bytes,9,opt,name=maintenance_level,json=maintenanceLevel,proto3" json:"maintenance_level,omitempty"`
+	RenovationStatus string                 `protobuf:"bytes,10,opt,name=renovation_status,json=renovationStatus,proto3" json:"renovation_status,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Condition) Reset() {
+	*x = Condition{}
+	mi := &file_proto_valuation_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Condition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Condition) ProtoMessage() {}
+
+func (x *Condition) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Condition.ProtoReflect.Descriptor instead.
+func (*Condition) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Condition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Condition) GetMultiplier() float64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+func (x *Condition) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Condition) GetCriteria() []string {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+func (x *Condition) GetMinAge() int32 {
+	if x != nil {
+		return x.MinAge
+	}
+	return 0
+}
+
+func (x *Condition) GetMaxAge() int32 {
+	if x != nil {
+		return x.MaxAge
+	}
+	return 0
+}
+
+func (x *Condition) GetRequiredFeatures() []string {
+	if x != nil {
+		return x.RequiredFeatures
+	}
+	return nil
+}
+
+func (x *Condition) GetExcludedFeatures() []string {
+	if x != nil {
+		return x.ExcludedFeatures
+	}
+	return nil
+}
+
+func (x *Condition) GetMaintenanceLevel() string {
+	if x != nil {
+		return x.MaintenanceLevel
+	}
+	return ""
+}
+
+func (x *Condition) GetRenovationStatus() string {
+	if x != nil {
+		return x.RenovationStatus
+	}
+	return ""
+}
+
+// ListConditionsResponse represents the conditions of a pricing model, best first,
+// together with the maintenance levels and renovation statuses a property may report
+type ListConditionsResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Conditions         []*Condition           `protobuf:"bytes,1,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	MaintenanceLevels  []string               `protobuf:"bytes,2,rep,name=maintenance_levels,json=maintenanceLevels,proto3" json:"maintenance_levels,omitempty"`
+	RenovationStatuses []string               `protobuf:"bytes,3,rep,name=renovation_statuses,json=renovationStatuses,proto3" json:"renovation_statuses,omitempty"`
+	ModelVersion       string                 `protobuf:"bytes,4,opt,name=model_version,json=modelVersion,proto3" json:"model_version,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListConditionsResponse) Reset() {
+	*x = ListConditionsResponse{}
+	mi := &file_proto_valuation_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConditionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConditionsResponse) ProtoMessage() {}
+
+func (x *ListConditionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConditionsResponse.ProtoReflect.Descriptor instead.
+func (*ListConditionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListConditionsResponse) GetConditions() []*Condition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *ListConditionsResponse) GetMaintenanceLevels() []string {
+	if x != nil {
+		return x.MaintenanceLevels
+	}
+	return nil
+}
+
+func (x *ListConditionsResponse) GetRenovationStatuses() []string {
+	if x != nil {
+		return x.RenovationStatuses
+	}
+	return nil
+}
+
+func (x *ListConditionsResponse) GetModelVersion() string {
+	if x != nil {
+		return x.ModelVersion
+	}
+	return ""
+}
+
+// ListFeaturesRequest represents a request for the features of the active pricing model
+type ListFeaturesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFeaturesRequest) Reset() {
+	*x = ListFeaturesRequest{}
+	mi := &file_proto_valuation_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFeaturesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFeaturesRequest) ProtoMessage() {}
+
+func (x *ListFeaturesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFeaturesRequest.ProtoReflect.Descriptor instead.
+func (*ListFeaturesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{44}
+}
+
+// Feature represents a property feature and the value it adds
+type Feature struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value         float64                `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Feature) Reset() {
+	*x = Feature{}
+	mi := &file_proto_valuation_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Feature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Feature) ProtoMessage() {}
+
+func (x *Feature) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Feature.ProtoReflect.Descriptor instead.
+func (*Feature) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *Feature) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Feature) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// ListFeaturesResponse represents the features of a pricing model, sorted by name
+type ListFeaturesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Features      []*Feature             `protobuf:"bytes,1,rep,name=features,proto3" json:"features,omitempty"`
+	ModelVersion  string                 `protobuf:"bytes,2,opt,name=model_version,json=modelVersion,proto3" json:"model_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFeaturesResponse) Reset() {
+	*x = ListFeaturesResponse{}
+	mi := &file_proto_valuation_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFeaturesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFeaturesResponse) ProtoMessage() {}
+
+func (x *ListFeaturesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFeaturesResponse.ProtoReflect.Descriptor instead.
+func (*ListFeaturesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListFeaturesResponse) GetFeatures() []*Feature {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+func (x *ListFeaturesResponse) GetModelVersion() string {
+	if x != nil {
+		return x.ModelVersion
+	}
+	return ""
+}
+
+// ListLocationClassesRequest represents a request for the location classes of the active pricing model
+type ListLocationClassesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLocationClassesRequest) Reset() {
+	*x = ListLocationClassesRequest{}
+	mi := &file_proto_valuation_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLocationClassesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLocationClassesRequest) ProtoMessage() {}
+
+func (x *ListLocationClassesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLocationClassesRequest.ProtoReflect.Descriptor instead.
+func (*ListLocationClassesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{47}
+}
+
+// LocationClass represents a market class and the multiplier applied to properties in it
+type LocationClass struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Multiplier    float64                `protobuf:"fixed64,2,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocationClass) Reset() {
+	*x = LocationClass{}
+	mi := &file_proto_valuation_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocationClass) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocationClass) ProtoMessage() {}
+
+func (x *LocationClass) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocationClass.ProtoReflect.Descriptor instead.
+func (*LocationClass) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *LocationClass) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LocationClass) GetMultiplier() float64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+// ListLocationClassesResponse represents the location classes of a pricing model, sorted by name
+type ListLocationClassesResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	LocationClasses []*LocationClass       `protobuf:"bytes,1,rep,name=location_classes,json=locationClasses,proto3" json:"location_classes,omitempty"`
+	ModelVersion    string                 `protobuf:"bytes,2,opt,name=model_version,json=modelVersion,proto3" json:"model_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListLocationClassesResponse) Reset() {
+	*x = ListLocationClassesResponse{}
+	mi := &file_proto_valuation_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLocationClassesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLocationClassesResponse) ProtoMessage() {}
+
+func (x *ListLocationClassesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLocationClassesResponse.ProtoReflect.Descriptor instead.
+func (*ListLocationClassesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListLocationClassesResponse) GetLocationClasses() []*LocationClass {
+	if x != nil {
+		return x.LocationClasses
+	}
+	return nil
+}
+
+func (x *ListLocationClassesResponse) GetModelVersion() string {
+	if x != nil {
+		return x.ModelVersion
+	}
+	return ""
+}
+
+// ReloadPricingModelRequest represents a request to reload the pricing model file
+type ReloadPricingModelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReloadPricingModelRequest) Reset() {
+	*x = ReloadPricingModelRequest{}
+	mi := &file_proto_valuation_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReloadPricingModelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadPricingModelRequest) ProtoMessage() {}
+
+func (x *ReloadPricingModelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadPricingModelRequest.ProtoReflect.Descriptor instead.
+func (*ReloadPricingModelRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{50}
+}
+
+// ReloadPricingModelResponse represents the pricing model active after a reload
+type ReloadPricingModelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ModelVersion  string                 `protobuf:"bytes,1,opt,name=model_version,json=modelVersion,proto3" json:"model_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReloadPricingModelResponse) Reset() {
+	*x = ReloadPricingModelResponse{}
+	mi := &file_proto_valuation_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReloadPricingModelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadPricingModelResponse) ProtoMessage() {}
+
+func (x *ReloadPricingModelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadPricingModelResponse.ProtoReflect.Descriptor instead.
+func (*ReloadPricingModelResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ReloadPricingModelResponse) GetModelVersion() string {
+	if x != nil {
+		return x.ModelVersion
+	}
+	return ""
+}
+
+// ListUsageRequest represents a request for the usage of every client during a month
+type ListUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Month         string                 `protobuf:"bytes,1,opt,name=month,proto3" json:"month,omitempty"` // YYYY-MM in UTC; the current month when empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsageRequest) Reset() {
+	*x = ListUsageRequest{}
+	mi := &file_proto_valuation_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsageRequest) ProtoMessage() {}
+
+func (x *ListUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsageRequest.ProtoReflect.Descriptor instead.
+func (*ListUsageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListUsageRequest) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+// ClientUsage represents the requests answered and valuations served for a client during a month
+type ClientUsage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Client        string                 `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`          // Authenticated caller, e.g. api_key:crm, or the IP address of anonymous callers
+	Requests      int64                  `protobuf:"varint,2,opt,name=requests,proto3" json:"requests,omitempty"`     // Requests, or stream messages, that served at least one valuation
+	Valuations    int64                  `protobuf:"varint,3,opt,name=valuations,proto3" json:"valuations,omitempty"` // A batch counts one valuation per successful item; includes those reserved by requests in flight
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClientUsage) Reset() {
+	*x = ClientUsage{}
+	mi := &file_proto_valuation_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientUsage) ProtoMessage() {}
+
+func (x *ClientUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientUsage.ProtoReflect.Descriptor instead.
+func (*ClientUsage) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ClientUsage) GetClient() string {
+	if x != nil {
+		return x.Client
+	}
+	return ""
+}
+
+func (x *ClientUsage) GetRequests() int64 {
+	if x != nil {
+		return x.Requests
+	}
+	return 0
+}
+
+func (x *ClientUsage) GetValuations() int64 {
+	if x != nil {
+		return x.Valuations
+	}
+	return 0
+}
+
+// ListUsageResponse represents the usage of every client during a month, sorted by client
+type ListUsageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Month         string                 `protobuf:"bytes,1,opt,name=month,proto3" json:"month,omitempty"`
+	Usage         []*ClientUsage         `protobuf:"bytes,2,rep,name=usage,proto3" json:"usage,omitempty"`
+	MonthlyQuota  int64                  `protobuf:"varint,3,opt,name=monthly_quota,json=monthlyQuota,proto3" json:"monthly_quota,omitempty"` // Valuations each client may be served per month; 0 when unlimited
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsageResponse) Reset() {
+	*x = ListUsageResponse{}
+	mi := &file_proto_valuation_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsageResponse) ProtoMessage() {}
+
+func (x *ListUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsageResponse.ProtoReflect.Descriptor instead.
+func (*ListUsageResponse) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListUsageResponse) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *ListUsageResponse) GetUsage() []*ClientUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+func (x *ListUsageResponse) GetMonthlyQuota() int64 {
+	if x != nil {
+		return x.MonthlyQuota
+	}
+	return 0
+}
+
+// PricingOverrides represents the prices a tenant sets in place of those of the
+// base pricing model; entries missing from the base model are added for the tenant
+type PricingOverrides struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	BasePricePerSquareFoot map[string]float64     `protobuf:"bytes,1,rep,name=base_price_per_square_foot,json=basePricePerSquareFoot,proto3" json:"base_price_per_square_foot,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"` // By property type
+	FeatureValue           map[string]float64     `protobuf:"bytes,2,rep,name=feature_value,json=featureValue,proto3" json:"feature_value,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`                                     // By feature
+	LocationMultiplier     map[string]float64     `protobuf:"bytes,3,rep,name=location_multiplier,json=locationMultiplier,proto3" json:"location_multiplier,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`                   // By location class
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *PricingOverrides) Reset() {
+	*x = PricingOverrides{}
+	mi := &file_proto_valuation_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PricingOverrides) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PricingOverrides) ProtoMessage() {}
+
+func (x *PricingOverrides) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PricingOverrides.ProtoReflect.Descriptor instead.
+func (*PricingOverrides) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *PricingOverrides) GetBasePricePerSquareFoot() map[string]float64 {
+	if x != nil {
+		return x.BasePricePerSquareFoot
+	}
+	return nil
+}
+
+func (x *PricingOverrides) GetFeatureValue() map[string]float64 {
+	if x != nil {
+		return x.FeatureValue
+	}
+	return nil
+}
+
+func (x *PricingOverrides) GetLocationMultiplier() map[string]float64 {
+	if x != nil {
+		return x.LocationMultiplier
+	}
+	return nil
+}
+
+// UpsertTenantPricingRequest represents a request to create or replace the pricing overrides of a tenant
+type UpsertTenantPricingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Overrides     *PricingOverrides      `protobuf:"bytes,2,opt,name=overrides,proto3" json:"overrides,omitempty"` // Replaces every previous override; the tenant uses the base model when empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertTenantPricingRequest) Reset() {
+	*x = UpsertTenantPricingRequest{}
+	mi := &file_proto_valuation_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertTenantPricingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertTenantPricingRequest) ProtoMessage() {}
+
+func (x *UpsertTenantPricingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertTenantPricingRequest.ProtoReflect.Descriptor instead.
+func (*UpsertTenantPricingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *UpsertTenantPricingRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *UpsertTenantPricingRequest) GetOverrides() *PricingOverrides {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
+
+// TenantPricing represents the pricing overrides of a tenant
+type TenantPricing struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Overrides     *PricingOverrides      `protobuf:"bytes,2,opt,name=overrides,proto3" json:"overrides,omitempty"`
+	Revision      int64                  `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"` // Incremented by every upsert
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	ModelVersion  string                 `protobuf:"bytes,5,opt,name=model_version,json=modelVersion,proto3" json:"model_version,omitempty"` // Version of the tenant's pricing model, e.g. 2024-06+acme.3
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TenantPricing) Reset() {
+	*x = TenantPricing{}
+	mi := &file_proto_valuation_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantPricing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantPricing) ProtoMessage() {}
+
+func (x *TenantPricing) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_valuation_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantPricing.ProtoReflect.Descriptor instead.
+func (*TenantPricing) Descriptor() ([]byte, []int) {
+	return file_proto_valuation_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *TenantPricing) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *TenantPricing) GetOverrides() *PricingOverrides {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
+
+func (x *TenantPricing) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *TenantPricing) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *TenantPricing) GetModelVersion() string {
+	if x != nil {
+		return x.ModelVersion
+	}
+	return ""
+}
+
+var File_proto_valuation_proto protoreflect.FileDescriptor
+
+const file_proto_valuation_proto_rawDesc = "" +
+	"\n" +
+	"\x15proto/valuation.proto\x12\tvaluation\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa6\x03\n" +
+	"\bProperty\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12#\n" +
+	"\rproperty_type\x18\x02 \x01(\tR\fpropertyType\x12\x1a\n" +
+	"\bbedrooms\x18\x03 \x01(\x05R\bbedrooms\x12\x1c\n" +
+	"\tbathrooms\x18\x04 \x01(\x05R\tbathrooms\x12%\n" +
+	"\x0esquare_footage\x18\x05 \x01(\x05R\rsquareFootage\x12\x1d\n" +
+	"\n" +
+	"year_built\x18\x06 \x01(\x05R\tyearBuilt\x12\x1c\n" +
+	"\tcondition\x18\a \x01(\tR\tcondition\x12+\n" +
+	"\x11maintenance_level\x18\b \x01(\tR\x10maintenanceLevel\x12+\n" +
+	"\x11renovation_status\x18\t \x01(\tR\x10renovationStatus\x12\x1a\n" +
+	"\bfeatures\x18\n" +
+	" \x03(\tR\bfeatures\x12/\n" +
+	"\blocation\x18\v \x01(\v2\x13.valuation.LocationR\blocation\x12\x16\n" +
+	"\x06region\x18\f \x01(\tR\x06region\"D\n" +
+	"\bLocation\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\"w\n" +
+	"\x05Issue\x12 \n" +
+	"\vdescription\x18\x01 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bseverity\x18\x02 \x01(\x01R\bseverity\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12\x14\n" +
+	"\x05field\x18\x04 \x01(\tR\x05field\"@\n" +
+	"\n" +
+	"Adjustment\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x16\n" +
+	"\x06factor\x18\x02 \x01(\x01R\x06factor\"A\n" +
+	"\x0fFeatureAddition\x12\x18\n" +
+	"\afeature\x18\x01 \x01(\tR\afeature\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\"\xe8\a\n" +
+	"\x12ValuationBreakdown\x121\n" +
+	"\x15price_per_square_foot\x18\x01 \x01(\x01R\x12pricePerSquareFoot\x12\x1d\n" +
+	"\n" +
+	"base_value\x18\x02 \x01(\x01R\tbaseValue\x12%\n" +
+	"\x0elocation_class\x18\x03 \x01(\tR\rlocationClass\x12/\n" +
+	"\x13location_multiplier\x18\x04 \x01(\x01R\x12locationMultiplier\x123\n" +
+	"\x15condition_description\x18\x05 \x01(\tR\x14conditionDescription\x121\n" +
+	"\x14condition_multiplier\x18\x06 \x01(\x01R\x13conditionMultiplier\x12)\n" +
+	"\x10validation_score\x18\a \x01(\x01R\x0fvalidationScore\x12L\n" +
+	"\x16validation_adjustments\x18\b \x03(\v2\x15.valuation.AdjustmentR\x15validationAdjustments\x12/\n" +
+	"\x13adjusted_multiplier\x18\t \x01(\x01R\x12adjustedMultiplier\x12G\n" +
+	"\x11feature_additions\x18\n" +
+	" \x03(\v2\x1a.valuation.FeatureAdditionR\x10featureAdditions\x12#\n" +
+	"\rfeature_value\x18\v \x01(\x01R\ffeatureValue\x12)\n" +
+	"\x10age_depreciation\x18\f \x01(\x01R\x0fageDepreciation\x12#\n" +
+	"\rbedroom_value\x18\r \x01(\x01R\fbedroomValue\x12%\n" +
+	"\x0ebathroom_value\x18\x0e \x01(\x01R\rbathroomValue\x12\x1f\n" +
+	"\vfinal_value\x18\x0f \x01(\x01R\n" +
+	"finalValue\x12>\n" +
+	"\vuncertainty\x18\x10 \x03(\v2\x1c.valuation.UncertaintySourceR\vuncertainty\x121\n" +
+	"\x14relative_uncertainty\x18\x11 \x01(\x01R\x13relativeUncertainty\x12A\n" +
+	"\x0evaluation_date\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\rvaluationDate\x12,\n" +
+	"\x12price_index_region\x18\x13 \x01(\tR\x10priceIndexRegion\x12,\n" +
+	"\x12price_index_factor\x18\x14 \x01(\x01R\x10priceIndexFactor\"^\n" +
+	"\x11UncertaintySource\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x121\n" +
+	"\x14relative_uncertainty\x18\x02 \x01(\x01R\x13relativeUncertainty\"J\n" +
+	"\x14ComparableAdjustment\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\"\xd9\x03\n" +
+	"\x0eComparableSale\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12#\n" +
+	"\rproperty_type\x18\x02 \x01(\tR\fpropertyType\x12%\n" +
+	"\x0esquare_footage\x18\x03 \x01(\x05R\rsquareFootage\x12\x1d\n" +
+	"\n" +
+	"year_built\x18\x04 \x01(\x05R\tyearBuilt\x12\x1c\n" +
+	"\tcondition\x18\x05 \x01(\tR\tcondition\x12\x1d\n" +
+	"\n" +
+	"sale_price\x18\x06 \x01(\x01R\tsalePrice\x127\n" +
+	"\tsale_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bsaleDate\x12\x1f\n" +
+	"\vdistance_km\x18\b \x01(\x01R\n" +
+	"distanceKm\x12A\n" +
+	"\vadjustments\x18\t \x03(\v2\x1f.valuation.ComparableAdjustmentR\vadjustments\x12%\n" +
+	"\x0eadjusted_price\x18\n" +
+	" \x01(\x01R\radjustedPrice\x12)\n" +
+	"\x10gross_adjustment\x18\v \x01(\x01R\x0fgrossAdjustment\x12\x16\n" +
+	"\x06weight\x18\f \x01(\x01R\x06weight\"u\n" +
+	"\bCashFlow\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x120\n" +
+	"\x14net_operating_income\x18\x02 \x01(\x01R\x12netOperatingIncome\x12#\n" +
+	"\rpresent_value\x18\x03 \x01(\x01R\fpresentValue\"\x8d\x04\n" +
+	"\x0eIncomeAnalysis\x124\n" +
+	"\x16potential_gross_income\x18\x01 \x01(\x01R\x14potentialGrossIncome\x12!\n" +
+	"\fvacancy_loss\x18\x02 \x01(\x01R\vvacancyLoss\x124\n" +
+	"\x16effective_gross_income\x18\x03 \x01(\x01R\x14effectiveGrossIncome\x12-\n" +
+	"\x12operating_expenses\x18\x04 \x01(\x01R\x11operatingExpenses\x120\n" +
+	"\x14net_operating_income\x18\x05 \x01(\x01R\x12netOperatingIncome\x12\x19\n" +
+	"\bcap_rate\x18\x06 \x01(\x01R\acapRate\x12>\n" +
+	"\x1bdirect_capitalization_value\x18\a \x01(\x01R\x19directCapitalizationValue\x122\n" +
+	"\n" +
+	"cash_flows\x18\b \x03(\v2\x13.valuation.CashFlowR\tcashFlows\x12'\n" +
+	"\x0freversion_value\x18\t \x01(\x01R\x0ereversionValue\x126\n" +
+	"\x17present_reversion_value\x18\n" +
+	" \x01(\x01R\x15presentReversionValue\x12\x1b\n" +
+	"\tdcf_value\x18\v \x01(\x01R\bdcfValue\"\xbc\x05\n" +
+	"\x0fValuationResult\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x01R\x05value\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x02 \x01(\x01R\n" +
+	"confidence\x12 \n" +
+	"\vexplanation\x18\x03 \x01(\tR\vexplanation\x12\x1a\n" +
+	"\x06issues\x18\x04 \x03(\tB\x02\x18\x01R\x06issues\x12=\n" +
+	"\x11validation_issues\x18\x05 \x03(\v2\x10.valuation.IssueR\x10validationIssues\x12;\n" +
+	"\tbreakdown\x18\x06 \x01(\v2\x1d.valuation.ValuationBreakdownR\tbreakdown\x12#\n" +
+	"\rmodel_version\x18\a \x01(\tR\fmodelVersion\x12;\n" +
+	"\vcomparables\x18\b \x03(\v2\x19.valuation.ComparableSaleR\vcomparables\x121\n" +
+	"\x06income\x18\t \x01(\v2\x19.valuation.IncomeAnalysisR\x06income\x122\n" +
+	"\x06method\x18\n" +
+	" \x01(\x0e2\x1a.valuation.ValuationMethodR\x06method\x128\n" +
+	"\n" +
+	"approaches\x18\v \x03(\v2\x18.valuation.ApproachValueR\n" +
+	"approaches\x126\n" +
+	"\vvalue_range\x18\f \x01(\v2\x15.valuation.ValueRangeR\n" +
+	"valueRange\x12!\n" +
+	"\fvaluation_id\x18\r \x01(\tR\vvaluationId\x12>\n" +
+	"\vsensitivity\x18\x0e \x03(\v2\x1c.valuation.SensitivityFactorR\vsensitivity\x12\x1b\n" +
+	"\ttenant_id\x18\x0f \x01(\tR\btenantId\"\xb7\x01\n" +
+	"\x11SensitivityFactor\x12\x14\n" +
+	"\x05input\x18\x01 \x01(\tR\x05input\x12\x1b\n" +
+	"\tlow_input\x18\x02 \x01(\tR\blowInput\x12\x1d\n" +
+	"\n" +
+	"high_input\x18\x03 \x01(\tR\thighInput\x12\x1b\n" +
+	"\tlow_value\x18\x04 \x01(\x01R\blowValue\x12\x1d\n" +
+	"\n" +
+	"high_value\x18\x05 \x01(\x01R\thighValue\x12\x14\n" +
+	"\x05swing\x18\x06 \x01(\x01R\x05swing\"\x8f\x01\n" +
+	"\rApproachValue\x12\x1a\n" +
+	"\bapproach\x18\x01 \x01(\tR\bapproach\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x03 \x01(\x01R\n" +
+	"confidence\x12\x16\n" +
+	"\x06weight\x18\x04 \x01(\x01R\x06weight\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"g\n" +
+	"\x05Lease\x12\x16\n" +
+	"\x06tenant\x18\x01 \x01(\tR\x06tenant\x12%\n" +
+	"\x0esquare_footage\x18\x02 \x01(\x05R\rsquareFootage\x12\x1f\n" +
+	"\vannual_rent\x18\x03 \x01(\x01R\n" +
+	"annualRent\"\xa4\x02\n" +
+	"\x19DiscountedCashFlowOptions\x120\n" +
+	"\x14holding_period_years\x18\x01 \x01(\x05R\x12holdingPeriodYears\x12#\n" +
+	"\rdiscount_rate\x18\x02 \x01(\x01R\fdiscountRate\x12(\n" +
+	"\x10rent_growth_rate\x18\x03 \x01(\x01R\x0erentGrowthRate\x12.\n" +
+	"\x13expense_growth_rate\x18\x04 \x01(\x01R\x11expenseGrowthRate\x12*\n" +
+	"\x11terminal_cap_rate\x18\x05 \x01(\x01R\x0fterminalCapRate\x12*\n" +
+	"\x11selling_cost_rate\x18\x06 \x01(\x01R\x0fsellingCostRate\"\x83\x02\n" +
+	"\n" +
+	"IncomeData\x12-\n" +
+	"\trent_roll\x18\x01 \x03(\v2\x10.valuation.LeaseR\brentRoll\x12!\n" +
+	"\fother_income\x18\x02 \x01(\x01R\votherIncome\x12!\n" +
+	"\fvacancy_rate\x18\x03 \x01(\x01R\vvacancyRate\x12-\n" +
+	"\x12operating_expenses\x18\x04 \x01(\x01R\x11operatingExpenses\x12\x19\n" +
+	"\bcap_rate\x18\x05 \x01(\x01R\acapRate\x126\n" +
+	"\x03dcf\x18\x06 \x01(\v2$.valuation.DiscountedCashFlowOptionsR\x03dcf\"\xe2\x02\n" +
+	"\x10ValuationRequest\x12/\n" +
+	"\bproperty\x18\x01 \x01(\v2\x13.valuation.PropertyR\bproperty\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\tR\trequestId\x122\n" +
+	"\x06method\x18\x03 \x01(\x0e2\x1a.valuation.ValuationMethodR\x06method\x12-\n" +
+	"\x06income\x18\x04 \x01(\v2\x15.valuation.IncomeDataR\x06income\x126\n" +
+	"\binterval\x18\x05 \x01(\v2\x1a.valuation.IntervalOptionsR\binterval\x12 \n" +
+	"\vsensitivity\x18\x06 \x01(\bR\vsensitivity\x12A\n" +
+	"\x0evaluation_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\rvaluationDate\"\x82\x02\n" +
+	"\x0fIntervalOptions\x12)\n" +
+	"\x10confidence_level\x18\x01 \x01(\x01R\x0fconfidenceLevel\x12\x1f\n" +
+	"\vmonte_carlo\x18\x02 \x01(\bR\n" +
+	"monteCarlo\x12 \n" +
+	"\vsimulations\x18\x03 \x01(\x05R\vsimulations\x128\n" +
+	"\x18square_footage_tolerance\x18\x04 \x01(\x01R\x16squareFootageTolerance\x123\n" +
+	"\x15condition_uncertainty\x18\x05 \x01(\x01R\x14conditionUncertainty\x12\x12\n" +
+	"\x04seed\x18\x06 \x01(\x04R\x04seed\"B\n" +
+	"\n" +
+	"Percentile\x12\x1e\n" +
+	"\n" +
+	"percentile\x18\x01 \x01(\x01R\n" +
+	"percentile\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\"\xae\x01\n" +
+	"\n" +
+	"ValueRange\x12\x10\n" +
+	"\x03low\x18\x01 \x01(\x01R\x03low\x12\x12\n" +
+	"\x04high\x18\x02 \x01(\x01R\x04high\x12)\n" +
+	"\x10confidence_level\x18\x03 \x01(\x01R\x0fconfidenceLevel\x12\x16\n" +
+	"\x06method\x18\x04 \x01(\tR\x06method\x127\n" +
+	"\vpercentiles\x18\x05 \x03(\v2\x15.valuation.PercentileR\vpercentiles\"G\n" +
+	"\x11ValuationResponse\x122\n" +
+	"\x06result\x18\x01 \x01(\v2\x1a.valuation.ValuationResultR\x06result\"H\n" +
+	"\x0eFieldViolation\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\"\x84\x01\n" +
+	"\x0eValuationError\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12D\n" +
+	"\x10field_violations\x18\x03 \x03(\v2\x19.valuation.FieldViolationR\x0ffieldViolations\"\xb8\x01\n" +
+	"\rValuationItem\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\tR\trequestId\x124\n" +
+	"\x06result\x18\x03 \x01(\v2\x1a.valuation.ValuationResultH\x00R\x06result\x121\n" +
+	"\x05error\x18\x04 \x01(\v2\x19.valuation.ValuationErrorH\x00R\x05errorB\t\n" +
+	"\aoutcome\"P\n" +
+	"\x15BatchValuationRequest\x127\n" +
+	"\brequests\x18\x01 \x03(\v2\x1b.valuation.ValuationRequestR\brequests\"H\n" +
+	"\x16BatchValuationResponse\x12.\n" +
+	"\x05items\x18\x01 \x03(\v2\x18.valuation.ValuationItemR\x05items\"\xa3\x02\n" +
+	"\x0fValuationRecord\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12#\n" +
+	"\rmodel_version\x18\x04 \x01(\tR\fmodelVersion\x125\n" +
+	"\arequest\x18\x05 \x01(\v2\x1b.valuation.ValuationRequestR\arequest\x122\n" +
+	"\x06result\x18\x06 \x01(\v2\x1a.valuation.ValuationResultR\x06result\x12\x1b\n" +
+	"\ttenant_id\x18\a \x01(\tR\btenantId\"%\n" +
+	"\x13GetValuationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xdf\x01\n" +
+	"\x15ListValuationsRequest\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x129\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\"|\n" +
+	"\x16ListValuationsResponse\x12:\n" +
+	"\n" +
+	"valuations\x18\x01 \x03(\v2\x1a.valuation.ValuationRecordR\n" +
+	"valuations\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"d\n" +
+	"\x17GetValuationAsOfRequest\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12/\n" +
+	"\x05as_of\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\"\xa6\x02\n" +
+	"\fModification\x12!\n" +
+	"\vadd_feature\x18\x01 \x01(\tH\x00R\n" +
+	"addFeature\x12'\n" +
+	"\x0eremove_feature\x18\x02 \x01(\tH\x00R\rremoveFeature\x12\x1e\n" +
+	"\tcondition\x18\x03 \x01(\tH\x00R\tcondition\x12-\n" +
+	"\x11maintenance_level\x18\x04 \x01(\tH\x00R\x10maintenanceLevel\x12-\n" +
+	"\x11renovation_status\x18\x05 \x01(\tH\x00R\x10renovationStatus\x12.\n" +
+	"\x12add_square_footage\x18\x06 \x01(\x05H\x00R\x10addSquareFootage\x12\x12\n" +
+	"\x04cost\x18\a \x01(\x01R\x04costB\b\n" +
+	"\x06change\"]\n" +
+	"\bScenario\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12=\n" +
+	"\rmodifications\x18\x02 \x03(\v2\x17.valuation.ModificationR\rmodifications\"u\n" +
+	"\x0fScenarioRequest\x12/\n" +
+	"\bproperty\x18\x01 \x01(\v2\x13.valuation.PropertyR\bproperty\x121\n" +
+	"\tscenarios\x18\x02 \x03(\v2\x13.valuation.ScenarioR\tscenarios\"k\n" +
+	"\x12ModificationImpact\x12 \n" +
+	"\vdescription\x18\x01 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04cost\x18\x02 \x01(\x01R\x04cost\x12\x1f\n" +
+	"\vvalue_delta\x18\x03 \x01(\x01R\n" +
+	"valueDelta\"\xa8\x02\n" +
+	"\x0eScenarioResult\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12/\n" +
+	"\bproperty\x18\x02 \x01(\v2\x13.valuation.PropertyR\bproperty\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\x01R\x05value\x12\x1f\n" +
+	"\vvalue_delta\x18\x04 \x01(\x01R\n" +
+	"valueDelta\x12\x12\n" +
+	"\x04cost\x18\x05 \x01(\x01R\x04cost\x12\x10\n" +
+	"\x03roi\x18\x06 \x01(\x01R\x03roi\x127\n" +
+	"\aimpacts\x18\a \x03(\v2\x1d.valuation.ModificationImpactR\aimpacts\x12;\n" +
+	"\tbreakdown\x18\b \x01(\v2\x1d.valuation.ValuationBreakdownR\tbreakdown\"\xf2\x01\n" +
+	"\x10ScenarioResponse\x12\x1d\n" +
+	"\n" +
+	"base_value\x18\x01 \x01(\x01R\tbaseValue\x12D\n" +
+	"\x0ebase_breakdown\x18\x02 \x01(\v2\x1d.valuation.ValuationBreakdownR\rbaseBreakdown\x127\n" +
+	"\tscenarios\x18\x03 \x03(\v2\x19.valuation.ScenarioResultR\tscenarios\x12#\n" +
+	"\rmodel_version\x18\x04 \x01(\tR\fmodelVersion\x12\x1b\n" +
+	"\ttenant_id\x18\x05 \x01(\tR\btenantId\"\x1a\n" +
+	"\x18ListPropertyTypesRequest\"~\n" +
+	"\fPropertyType\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x121\n" +
+	"\x15price_per_square_foot\x18\x02 \x01(\x01R\x12pricePerSquareFoot\x12'\n" +
+	"\x0fincome_approach\x18\x03 \x01(\bR\x0eincomeApproach\"\x80\x01\n" +
+	"\x19ListPropertyTypesResponse\x12>\n" +
+	"\x0eproperty_types\x18\x01 \x03(\v2\x17.valuation.PropertyTypeR\rpropertyTypes\x12#\n" +
+	"\rmodel_version\x18\x02 \x01(\tR\fmodelVersion\"\x17\n" +
+	"\x15ListConditionsRequest\"\xe3\x02\n" +
+	"\tCondition\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"multiplier\x18\x02 \x01(\x01R\n" +
+	"multiplier\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bcriteria\x18\x04 \x03(\tR\bcriteria\x12\x17\n" +
+	"\amin_age\x18\x05 \x01(\x05R\x06minAge\x12\x17\n" +
+	"\amax_age\x18\x06 \x01(\x05R\x06maxAge\x12+\n" +
+	"\x11required_features\x18\a \x03(\tR\x10requiredFeatures\x12+\n" +
+	"\x11excluded_features\x18\b \x03(\tR\x10excludedFeatures\x12+\n" +
+	"\x11maintenance_level\x18\t \x01(\tR\x10maintenanceLevel\x12+\n" +
+	"\x11renovation_status\x18\n" +
+	" \x01(\tR\x10renovationStatus\"\xd3\x01\n" +
+	"\x16ListConditionsResponse\x124\n" +
+	"\n" +
+	"conditions\x18\x01 \x03(\v2\x14.valuation.ConditionR\n" +
+	"conditions\x12-\n" +
+	"\x12maintenance_levels\x18\x02 \x03(\tR\x11maintenanceLevels\x12/\n" +
+	"\x13renovation_statuses\x18\x03 \x03(\tR\x12renovationStatuses\x12#\n" +
+	"\rmodel_version\x18\x04 \x01(\tR\fmodelVersion\"\x15\n" +
+	"\x13ListFeaturesRequest\"3\n" +
+	"\aFeature\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\"k\n" +
+	"\x14ListFeaturesResponse\x12.\n" +
+	"\bfeatures\x18\x01 \x03(\v2\x12.valuation.FeatureR\bfeatures\x12#\n" +
+	"\rmodel_version\x18\x02 \x01(\tR\fmodelVersion\"\x1c\n" +
+	"\x1aListLocationClassesRequest\"C\n" +
+	"\rLocationClass\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"multiplier\x18\x02 \x01(\x01R\n" +
+	"multiplier\"\x87\x01\n" +
+	"\x1bListLocationClassesResponse\x12C\n" +
+	"\x10location_classes\x18\x01 \x03(\v2\x18.valuation.LocationClassR\x0flocationClasses\x12#\n" +
+	"\rmodel_version\x18\x02 \x01(\tR\fmodelVersion\"\x1b\n" +
+	"\x19ReloadPricingModelRequest\"A\n" +
+	"\x1aReloadPricingModelResponse\x12#\n" +
+	"\rmodel_version\x18\x01 \x01(\tR\fmodelVersion\"(\n" +
+	"\x10ListUsageRequest\x12\x14\n" +
+	"\x05month\x18\x01 \x01(\tR\x05month\"a\n" +
+	"\vClientUsage\x12\x16\n" +
+	"\x06client\x18\x01 \x01(\tR\x06client\x12\x1a\n" +
+	"\brequests\x18\x02 \x01(\x03R\brequests\x12\x1e\n" +
+	"\n" +
+	"valuations\x18\x03 \x01(\x03R\n" +
+	"valuations\"|\n" +
+	"\x11ListUsageResponse\x12\x14\n" +
+	"\x05month\x18\x01 \x01(\tR\x05month\x12,\n" +
+	"\x05usage\x18\x02 \x03(\v2\x16.valuation.ClientUsageR\x05usage\x12#\n" +
+	"\rmonthly_quota\x18\x03 \x01(\x03R\fmonthlyQuota\"\x94\x04\n" +
+	"\x10PricingOverrides\x12s\n" +
+	"\x1abase_price_per_square_foot\x18\x01 \x03(\v27.valuation.PricingOverrides.BasePricePerSquareFootEntryR\x16basePricePerSquareFoot\x12R\n" +
+	"\rfeature_value\x18\x02 \x03(\v2-.valuation.PricingOverrides.FeatureValueEntryR\ffeatureValue\x12d\n" +
+	"\x13location_multiplier\x18\x03 \x03(\v23.valuation.PricingOverrides.LocationMultiplierEntryR\x12locationMultiplier\x1aI\n" +
+	"\x1bBasePricePerSquareFootEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\x1a?\n" +
+	"\x11FeatureValueEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\x1aE\n" +
+	"\x17LocationMultiplierEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"t\n" +
+	"\x1aUpsertTenantPricingRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x129\n" +
+	"\toverrides\x18\x02 \x01(\v2\x1b.valuation.PricingOverridesR\toverrides\"\xe5\x01\n" +
+	"\rTenantPricing\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x129\n" +
+	"\toverrides\x18\x02 \x01(\v2\x1b.valuation.PricingOverridesR\toverrides\x12\x1a\n" +
+	"\brevision\x18\x03 \x01(\x03R\brevision\x12;\n" +
+	"\vupdate_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\x12#\n" +
+	"\rmodel_version\x18\x05 \x01(\tR\fmodelVersion*\xb3\x01\n" +
+	"\x0fValuationMethod\x12 \n" +
+	"\x1cVALUATION_METHOD_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15VALUATION_METHOD_COST\x10\x01\x12%\n" +
+	"!VALUATION_METHOD_SALES_COMPARISON\x10\x02\x12\x1b\n" +
+	"\x17VALUATION_METHOD_INCOME\x10\x03\x12\x1f\n" +
+	"\x1bVALUATION_METHOD_RECONCILED\x10\x042\xbd\n" +
+	"\n" +
+	"\x10ValuationService\x12Q\n" +
+	"\x12CalculateValuation\x12\x1b.valuation.ValuationRequest\x1a\x1c.valuation.ValuationResponse\"\x00\x12W\n" +
+	"\x18CalculateSalesComparison\x12\x1b.valuation.ValuationRequest\x1a\x1c.valuation.ValuationResponse\"\x00\x12`\n" +
+	"\x17BatchCalculateValuation\x12 .valuation.BatchValuationRequest\x1a!.valuation.BatchValuationResponse\"\x00\x12O\n" +
+	"\x10StreamValuations\x12\x1b.valuation.ValuationRequest\x1a\x18.valuation.ValuationItem\"\x00(\x010\x01\x12L\n" +
+	"\fGetValuation\x12\x1e.valuation.GetValuationRequest\x1a\x1a.valuation.ValuationRecord\"\x00\x12W\n" +
+	"\x0eListValuations\x12 .valuation.ListValuationsRequest\x1a!.valuation.ListValuationsResponse\"\x00\x12T\n" +
+	"\x10GetValuationAsOf\x12\".valuation.GetValuationAsOfRequest\x1a\x1a.valuation.ValuationRecord\"\x00\x12N\n" +
+	"\x11SimulateScenarios\x12\x1a.valuation.ScenarioRequest\x1a\x1b.valuation.ScenarioResponse\"\x00\x12`\n" +
+	"\x11ListPropertyTypes\x12#.valuation.ListPropertyTypesRequest\x1a$.valuation.ListPropertyTypesResponse\"\x00\x12W\n" +
+	"\x0eListConditions\x12 .valuation.ListConditionsRequest\x1a!.valuation.ListConditionsResponse\"\x00\x12Q\n" +
+	"\fListFeatures\x12\x1e.valuation.ListFeaturesRequest\x1a\x1f.valuation.ListFeaturesResponse\"\x00\x12f\n" +
+	"\x13ListLocationClasses\x12%.valuation.ListLocationClassesRequest\x1a&.valuation.ListLocationClassesResponse\"\x00\x12c\n" +
+	"\x12ReloadPricingModel\x12$.valuation.ReloadPricingModelRequest\x1a%.valuation.ReloadPricingModelResponse\"\x00\x12H\n" +
+	"\tListUsage\x12\x1b.valuation.ListUsageRequest\x1a\x1c.valuation.ListUsageResponse\"\x00\x12X\n" +
+	"\x13UpsertTenantPricing\x12%.valuation.UpsertTenantPricingRequest\x1a\x18.valuation.TenantPricing\"\x00B6Z4github.com/jsarcade/property-valuation-service/protob\x06proto3"
+
+var (
+	file_proto_valuation_proto_rawDescOnce sync.Once
+	file_proto_valuation_proto_rawDescData []byte
+)
+
+func file_proto_valuation_proto_rawDescGZIP() []byte {
+	file_proto_valuation_proto_rawDescOnce.Do(func() {
+		file_proto_valuation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_valuation_proto_rawDesc), len(file_proto_valuation_proto_rawDesc)))
+	})
+	return file_proto_valuation_proto_rawDescData
+}
+
+var file_proto_valuation_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_valuation_proto_msgTypes = make([]protoimpl.MessageInfo, 61)
+var file_proto_valuation_proto_goTypes = []any{
+	(ValuationMethod)(0),                // 0: valuation.ValuationMethod
+	(*Property)(nil),                    // 1: valuation.Property
+	(*Location)(nil),                    // 2: valuation.Location
+	(*Issue)(nil),                       // 3: valuation.Issue
+	(*Adjustment)(nil),                  // 4: valuation.Adjustment
+	(*FeatureAddition)(nil),             // 5: valuation.FeatureAddition
+	(*ValuationBreakdown)(nil),          // 6: valuation.ValuationBreakdown
+	(*UncertaintySource)(nil),           // 7: valuation.UncertaintySource
+	(*ComparableAdjustment)(nil),        // 8: valuation.ComparableAdjustment
+	(*ComparableSale)(nil),              // 9: valuation.ComparableSale
+	(*CashFlow)(nil),                    // 10: valuation.CashFlow
+	(*IncomeAnalysis)(nil),              // 11: valuation.IncomeAnalysis
+	(*ValuationResult)(nil),             // 12: valuation.ValuationResult
+	(*SensitivityFactor)(nil),           // 13: valuation.SensitivityFactor
+	(*ApproachValue)(nil),               // 14: valuation.ApproachValue
+	(*Lease)(nil),                       // 15: valuation.Lease
+	(*DiscountedCashFlowOptions)(nil),   // 16: valuation.DiscountedCashFlowOptions
+	(*IncomeData)(nil),                  // 17: valuation.IncomeData
+	(*ValuationRequest)(nil),            // 18: valuation.ValuationRequest
+	(*IntervalOptions)(nil),             // 19: valuation.IntervalOptions
+	(*Percentile)(nil),                  // 20: valuation.Percentile
+	(*ValueRange)(nil),                  // 21: valuation.ValueRange
+	(*ValuationResponse)(nil),           // 22: valuation.ValuationResponse
+	(*FieldViolation)(nil),              // 23: valuation.FieldViolation
+	(*ValuationError)(nil),              // 24: valuation.ValuationError
+	(*ValuationItem)(nil),               // 25: valuation.ValuationItem
+	(*BatchValuationRequest)(nil),       // 26: valuation.BatchValuationRequest
+	(*BatchValuationResponse)(nil),      // 27: valuation.BatchValuationResponse
+	(*ValuationRecord)(nil),             // 28: valuation.ValuationRecord
+	(*GetValuationRequest)(nil),         // 29: valuation.GetValuationRequest
+	(*ListValuationsRequest)(nil),       // 30: valuation.ListValuationsRequest
+	(*ListValuationsResponse)(nil),      // 31: valuation.ListValuationsResponse
+	(*GetValuationAsOfRequest)(nil),     // 32: valuation.GetValuationAsOfRequest
+	(*Modification)(nil),                // 33: valuation.Modification
+	(*Scenario)(nil),                    // 34: valuation.Scenario
+	(*ScenarioRequest)(nil),             // 35: valuation.ScenarioRequest
+	(*ModificationImpact)(nil),          // 36: valuation.ModificationImpact
+	(*ScenarioResult)(nil),              // 37: valuation.ScenarioResult
+	(*ScenarioResponse)(nil),            // 38: valuation.ScenarioResponse
+	(*ListPropertyTypesRequest)(nil),    // 39: valuation.ListPropertyTypesRequest
+	(*PropertyType)(nil),                // 40: valuation.PropertyType
+	(*ListPropertyTypesResponse)(nil),   // 41: valuation.ListPropertyTypesResponse
+	(*ListConditionsRequest)(nil),       // 42: valuation.ListConditionsRequest
+	(*Condition)(nil),                   // 43: valuation.Condition
+	(*ListConditionsResponse)(nil),      // 44: valuation.ListConditionsResponse
+	(*ListFeaturesRequest)(nil),         // 45: valuation.ListFeaturesRequest
+	(*Feature)(nil),                     // 46: valuation.Feature
+	(*ListFeaturesResponse)(nil),        // 47: valuation.ListFeaturesResponse
+	(*ListLocationClassesRequest)(nil),  // 48: valuation.ListLocationClassesRequest
+	(*LocationClass)(nil),               // 49: valuation.LocationClass
+	(*ListLocationClassesResponse)(nil), // 50: valuation.ListLocationClassesResponse
+	(*ReloadPricingModelRequest)(nil),   // 51: valuation.ReloadPricingModelRequest
+	(*ReloadPricingModelResponse)(nil),  // 52: valuation.ReloadPricingModelResponse
+	(*ListUsageRequest)(nil),            // 53: valuation.ListUsageRequest
+	(*ClientUsage)(nil),                 // 54: valuation.ClientUsage
+	(*ListUsageResponse)(nil),           // 55: valuation.ListUsageResponse
+	(*PricingOverrides)(nil),            // 56: valuation.PricingOverrides
+	(*UpsertTenantPricingRequest)(nil),  // 57: valuation.UpsertTenantPricingRequest
+	(*TenantPricing)(nil),               // 58: valuation.TenantPricing
+	nil,                                 // 59: valuation.PricingOverrides.BasePricePerSquareFootEntry
+	nil,                                 // 60: valuation.PricingOverrides.FeatureValueEntry
+	nil,                                 // 61: valuation.PricingOverrides.LocationMultiplierEntry
+	(*timestamppb.Timestamp)(nil),       // 62: google.protobuf.Timestamp
+}
+var file_proto_valuation_proto_depIdxs = []int32{
+	2,  // 0: valuation.Property.location:type_name -> valuation.Location
+	4,  // 1: valuation.ValuationBreakdown.validation_adjustments:type_name -> valuation.Adjustment
+	5,  // 2: valuation.ValuationBreakdown.feature_additions:type_name -> valuation.FeatureAddition
+	7,  // 3: valuation.ValuationBreakdown.uncertainty:type_name -> valuation.UncertaintySource
+	62, // 4: valuation.ValuationBreakdown.valuation_date:type_name -> google.protobuf.Timestamp
+	62, // 5: valuation.ComparableSale.sale_date:type_name -> google.protobuf.Timestamp
+	8,  // 6: valuation.ComparableSale.adjustments:type_name -> valuation.ComparableAdjustment
+	10, // 7: valuation.IncomeAnalysis.cash_flows:type_name -> valuation.CashFlow
+	3,  // 8: valuation.ValuationResult.validation_issues:type_name -> valuation.Issue
+	6,  // 9: valuation.ValuationResult.breakdown:type_name -> valuation.ValuationBreakdown
+	9,  // 10: valuation.ValuationResult.comparables:type_name -> valuation.ComparableSale
+	11, // 11: valuation.ValuationResult.income:type_name -> valuation.IncomeAnalysis
+	0,  // 12: valuation.ValuationResult.method:type_name -> valuation.ValuationMethod
+	14, // 13: valuation.ValuationResult.approaches:type_name -> valuation.ApproachValue
+	21, // 14: valuation.ValuationResult.value_range:type_name -> valuation.ValueRange
+	13, // 15: valuation.ValuationResult.sensitivity:type_name -> valuation.SensitivityFactor
+	15, // 16: valuation.IncomeData.rent_roll:type_name -> valuation.Lease
+	16, // 17: valuation.IncomeData.dcf:type_name -> valuation.DiscountedCashFlowOptions
+	1,  // 18: valuation.ValuationRequest.property:type_name -> valuation.Property
+	0,  // 19: valuation.ValuationRequest.method:type_name -> valuation.ValuationMethod
+	17, // 20: valuation.ValuationRequest.income:type_name -> valuation.IncomeData
+	19, // 21: valuation.ValuationRequest.interval:type_name -> valuation.IntervalOptions
+	62, // 22: valuation.ValuationRequest.valuation_date:type_name -> google.protobuf.Timestamp
+	20, // 23: valuation.ValueRange.percentiles:type_name -> valuation.Percentile
+	12, // 24: valuation.ValuationResponse.result:type_name -> valuation.ValuationResult
+	23, // 25: valuation.ValuationError.field_violations:type_name -> valuation.FieldViolation
+	12, // 26: valuation.ValuationItem.result:type_name -> valuation.ValuationResult
+	24, // 27: valuation.ValuationItem.error:type_name -> valuation.ValuationError
+	18, // 28: valuation.BatchValuationRequest.requests:type_name -> valuation.ValuationRequest
+	25, // 29: valuation.BatchValuationResponse.items:type_name -> valuation.ValuationItem
+	62, // 30: valuation.ValuationRecord.created_at:type_name -> google.protobuf.Timestamp
+	18, // 31: valuation.ValuationRecord.request:type_name -> valuation.ValuationRequest
+	12, // 32: valuation.ValuationRecord.result:type_name -> valuation.ValuationResult
+	62, // 33: valuation.ListValuationsRequest.start_time:type_name -> google.protobuf.Timestamp
+	62, // 34: valuation.ListValuationsRequest.end_time:type_name -> google.protobuf.Timestamp
+	28, // 35: valuation.ListValuationsResponse.valuations:type_name -> valuation.ValuationRecord
+	62, // 36: valuation.GetValuationAsOfRequest.as_of:type_name -> google.protobuf.Timestamp
+	33, // 37: valuation.Scenario.modifications:type_name -> valuation.Modification
+	1,  // 38: valuation.ScenarioRequest.property:type_name -> valuation.Property
+	34, // 39: valuation.ScenarioRequest.scenarios:type_name -> valuation.Scenario
+	1,  // 40: valuation.ScenarioResult.property:type_name -> valuation.Property
+	36, // 41: valuation.ScenarioResult.impacts:type_name -> valuation.ModificationImpact
+	6,  // 42: valuation.ScenarioResult.breakdown:type_name -> valuation.ValuationBreakdown
+	6,  // 43: valuation.ScenarioResponse.base_breakdown:type_name -> valuation.ValuationBreakdown
+	37, // 44: valuation.ScenarioResponse.scenarios:type_name -> valuation.ScenarioResult
+	40, // 45: valuation.ListPropertyTypesResponse.property_types:type_name -> valuation.PropertyType
+	43, // 46: valuation.ListConditionsResponse.conditions:type_name -> valuation.Condition
+	46, // 47: valuation.ListFeaturesResponse.features:type_name -> valuation.Feature
+	49, // 48: valuation.ListLocationClassesResponse.location_classes:type_name -> valuation.LocationClass
+	54, // 49: valuation.ListUsageResponse.usage:type_name -> valuation.ClientUsage
+	59, // 50: valuation.PricingOverrides.base_price_per_square_foot:type_name -> valuation.PricingOverrides.BasePricePerSquareFootEntry
+	60, // 51: valuation.PricingOverrides.feature_value:type_name -> valuation.PricingOverrides.FeatureValueEntry
+	61, // 52: valuation.PricingOverrides.location_multiplier:type_name -> valuation.PricingOverrides.LocationMultiplierEntry
+	56, // 53: valuation.UpsertTenantPricingRequest.overrides:type_name -> valuation.PricingOverrides
+	56, // 54: valuation.TenantPricing.overrides:type_name -> valuation.PricingOverrides
+	62, // 55: valuation.TenantPricing.update_time:type_name -> google.protobuf.Timestamp
+	18, // 56: valuation.ValuationService.CalculateValuation:input_type -> valuation.ValuationRequest
+	18, // 57: valuation.ValuationService.CalculateSalesComparison:input_type -> valuation.ValuationRequest
+	26, // 58: valuation.ValuationService.BatchCalculateValuation:input_type -> valuation.BatchValuationRequest
+	18, // 59: valuation.ValuationService.StreamValuations:input_type -> valuation.ValuationRequest
+	29, // 60: valuation.ValuationService.GetValuation:input_type -> valuation.GetValuationRequest
+	30, // 61: valuation.ValuationService.ListValuations:input_type -> valuation.ListValuationsRequest
+	32, // 62: valuation.ValuationService.GetValuationAsOf:input_type -> valuation.GetValuationAsOfRequest
+	35, // 63: valuation.ValuationService.SimulateScenarios:input_type -> valuation.ScenarioRequest
+	39, // 64: valuation.ValuationService.ListPropertyTypes:input_type -> valuation.ListPropertyTypesRequest
+	42, // 65: valuation.ValuationService.ListConditions:input_type -> valuation.ListConditionsRequest
+	45, // 66: valuation.ValuationService.ListFeatures:input_type -> valuation.ListFeaturesRequest
+	48, // 67: valuation.ValuationService.ListLocationClasses:input_type -> valuation.ListLocationClassesRequest
+	51, // 68: valuation.ValuationService.ReloadPricingModel:input_type -> valuation.ReloadPricingModelRequest
+	53, // 69: valuation.ValuationService.ListUsage:input_type -> valuation.ListUsageRequest
+	57, // 70: valuation.ValuationService.UpsertTenantPricing:input_type -> valuation.UpsertTenantPricingRequest
+	22, // 71: valuation.ValuationService.CalculateValuation:output_type -> valuation.ValuationResponse
+	22, // 72: valuation.ValuationService.CalculateSalesComparison:output_type -> valuation.ValuationResponse
+	27, // 73: valuation.ValuationService.BatchCalculateValuation:output_type -> valuation.BatchValuationResponse
+	25, // 74: valuation.ValuationService.StreamValuations:output_type -> valuation.ValuationItem
+	28, // 75: valuation.ValuationService.GetValuation:output_type -> valuation.ValuationRecord
+	31, // 76: valuation.ValuationService.ListValuations:output_type -> valuation.ListValuationsResponse
+	28, // 77: valuation.ValuationService.GetValuationAsOf:output_type -> valuation.ValuationRecord
+	38, // 78: valuation.ValuationService.SimulateScenarios:output_type -> valuation.ScenarioResponse
+	41, // 79: valuation.ValuationService.ListPropertyTypes:output_type -> valuation.ListPropertyTypesResponse
+	44, // 80: valuation.ValuationService.ListConditions:output_type -> valuation.ListConditionsResponse
+	47, // 81: valuation.ValuationService.ListFeatures:output_type -> valuation.ListFeaturesResponse
+	50, // 82: valuation.ValuationService.ListLocationClasses:output_type -> valuation.ListLocationClassesResponse
+	52, // 83: valuation.ValuationService.ReloadPricingModel:output_type -> valuation.ReloadPricingModelResponse
+	55, // 84: valuation.ValuationService.ListUsage:output_type -> valuation.ListUsageResponse
+	58, // 85: valuation.ValuationService.UpsertTenantPricing:output_type -> valuation.TenantPricing
+	71, // [71:86] is the sub-list for method output_type
+	56, // [56:71] is the sub-list for method input_type
+	56, // [56:56] is the sub-list for extension type_name
+	56, // [56:56] is the sub-list for extension extendee
+	0,  // [0:56] is the sub-list for field type_name
+}
+
+func init() { file_proto_valuation_proto_init() }
+func file_proto_valuation_proto_init() {
+	if File_proto_valuation_proto != nil {
 		return
 	}
+	file_proto_valuation_proto_msgTypes[24].OneofWrappers = []any{
+		(*ValuationItem_Result)(nil),
+		(*ValuationItem_Error)(nil),
+	}
+	file_proto_valuation_proto_msgTypes[32].OneofWrappers = []any{
+		(*Modification_AddFeature)(nil),
+		(*Modification_RemoveFeature)(nil),
+		(*Modification_Condition)(nil),
+		(*Modification_MaintenanceLevel)(nil),
+		(*Modification_RenovationStatus)(nil),
+		(*Modification_AddSquareFootage)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_valuation_proto_rawDesc), len(file_proto_valuation_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   4,
+			NumEnums:      1,
+			NumMessages:   61,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_valuation_proto_goTypes,
 		DependencyIndexes: file_proto_valuation_proto_depIdxs,
+		EnumInfos:         file_proto_valuation_proto_enumTypes,
 		MessageInfos:      file_proto_valuation_proto_msgTypes,
 	}.Build()
 	File_proto_valuation_proto = out.File
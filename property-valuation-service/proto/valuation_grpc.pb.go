@@ -19,17 +19,62 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ValuationService_CalculateValuation_FullMethodName = "/valuation.ValuationService/CalculateValuation"
+	ValuationService_CalculateValuation_FullMethodName       = "/valuation.ValuationService/CalculateValuation"
+	ValuationService_CalculateSalesComparison_FullMethodName = "/valuation.ValuationService/CalculateSalesComparison"
+	ValuationService_BatchCalculateValuation_FullMethodName  = "/valuation.ValuationService/BatchCalculateValuation"
+	ValuationService_StreamValuations_FullMethodName         = "/valuation.ValuationService/StreamValuations"
+	ValuationService_GetValuation_FullMethodName             = "/valuation.ValuationService/GetValuation"
+	ValuationService_ListValuations_FullMethodName           = "/valuation.ValuationService/ListValuations"
+	ValuationService_GetValuationAsOf_FullMethodName         = "/valuation.ValuationService/GetValuationAsOf"
+	ValuationService_SimulateScenarios_FullMethodName        = "/valuation.ValuationService/SimulateScenarios"
+	ValuationService_ListPropertyTypes_FullMethodName        = "/valuation.ValuationService/ListPropertyTypes"
+	ValuationService_ListConditions_FullMethodName           = "/valuation.ValuationService/ListConditions"
+	ValuationService_ListFeatures_FullMethodName             = "/valuation.ValuationService/ListFeatures"
+	ValuationService_ListLocationClasses_FullMethodName      = "/valuation.ValuationService/ListLocationClasses"
+	ValuationService_ReloadPricingModel_FullMethodName       = "/valuation.ValuationService/ReloadPricingModel"
+	ValuationService_ListUsage_FullMethodName                = "/valuation.ValuationService/ListUsage"
+	ValuationService_UpsertTenantPricing_FullMethodName      = "/valuation.ValuationService/UpsertTenantPricing"
 )
 
 // ValuationServiceClient is the client API for ValuationService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-//
-// ValuationService provides methods for property valuation
 type ValuationServiceClient interface {
 	// CalculateValuation calculates the value of a property
 	CalculateValuation(ctx context.Context, in *ValuationRequest, opts ...grpc.CallOption) (*ValuationResponse, error)
+	// CalculateSalesComparison values a property from recent comparable sales
+	CalculateSalesComparison(ctx context.Context, in *ValuationRequest, opts ...grpc.CallOption) (*ValuationResponse, error)
+	// BatchCalculateValuation values every property of the batch concurrently;
+	// invalid items are reported individually without failing the batch
+	BatchCalculateValuation(ctx context.Context, in *BatchValuationRequest, opts ...grpc.CallOption) (*BatchValuationResponse, error)
+	// StreamValuations values properties as they arrive and streams back each
+	// item as soon as it is ready, which may be out of request order
+	StreamValuations(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ValuationRequest, ValuationItem], error)
+	// GetValuation returns a stored valuation by ID. Callers read the valuations of their tenant,
+	// administrators those of every tenant.
+	GetValuation(ctx context.Context, in *GetValuationRequest, opts ...grpc.CallOption) (*ValuationRecord, error)
+	// ListValuations returns the stored valuations of an address within a time range made for the caller's tenant
+	ListValuations(ctx context.Context, in *ListValuationsRequest, opts ...grpc.CallOption) (*ListValuationsResponse, error)
+	// GetValuationAsOf returns the latest valuation of an address made for the caller's tenant at or before a point in time
+	GetValuationAsOf(ctx context.Context, in *GetValuationAsOfRequest, opts ...grpc.CallOption) (*ValuationRecord, error)
+	// SimulateScenarios values what-if modifications of a property and reports the
+	// value they add and their return on investment
+	SimulateScenarios(ctx context.Context, in *ScenarioRequest, opts ...grpc.CallOption) (*ScenarioResponse, error)
+	// ListPropertyTypes returns the property types of the active pricing model
+	ListPropertyTypes(ctx context.Context, in *ListPropertyTypesRequest, opts ...grpc.CallOption) (*ListPropertyTypesResponse, error)
+	// ListConditions returns the conditions of the active pricing model and their criteria
+	ListConditions(ctx context.Context, in *ListConditionsRequest, opts ...grpc.CallOption) (*ListConditionsResponse, error)
+	// ListFeatures returns the features of the active pricing model and the value they add
+	ListFeatures(ctx context.Context, in *ListFeaturesRequest, opts ...grpc.CallOption) (*ListFeaturesResponse, error)
+	// ListLocationClasses returns the location classes of the active pricing model
+	ListLocationClasses(ctx context.Context, in *ListLocationClassesRequest, opts ...grpc.CallOption) (*ListLocationClassesResponse, error)
+	// ReloadPricingModel reloads the pricing model from its file; restricted to administrators
+	ReloadPricingModel(ctx context.Context, in *ReloadPricingModelRequest, opts ...grpc.CallOption) (*ReloadPricingModelResponse, error)
+	// ListUsage returns the monthly usage counted for billing; restricted to administrators
+	ListUsage(ctx context.Context, in *ListUsageRequest, opts ...grpc.CallOption) (*ListUsageResponse, error)
+	// UpsertTenantPricing creates or replaces the pricing overrides a tenant's valuations
+	// are made with; restricted to administrators
+	UpsertTenantPricing(ctx context.Context, in *UpsertTenantPricingRequest, opts ...grpc.CallOption) (*TenantPricing, error)
 }
 
 type valuationServiceClient struct {
@@ -50,14 +95,188 @@ func (c *valuationServiceClient) CalculateValuation(ctx context.Context, in *Val
 	return out, nil
 }
 
+func (c *valuationServiceClient) CalculateSalesComparison(ctx context.Context, in *ValuationRequest, opts ...grpc.CallOption) (*ValuationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValuationResponse)
+	err := c.cc.Invoke(ctx, ValuationService_CalculateSalesComparison_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *valuationServiceClient) BatchCalculateValuation(ctx context.Context, in *BatchValuationRequest, opts ...grpc.CallOption) (*BatchValuationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchValuationResponse)
+	err := c.cc.Invoke(ctx, ValuationService_BatchCalculateValuation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *valuationServiceClient) StreamValuations(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ValuationRequest, ValuationItem], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ValuationService_ServiceDesc.Streams[0], ValuationService_StreamValuations_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ValuationRequest, ValuationItem]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ValuationService_StreamValuationsClient = grpc.BidiStreamingClient[ValuationRequest, ValuationItem]
+
+func (c *valuationServiceClient) GetValuation(ctx context.Context, in *GetValuationRequest, opts ...grpc.CallOption) (*ValuationRecord, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValuationRecord)
+	err := c.cc.Invoke(ctx, ValuationService_GetValuation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *valuationServiceClient) ListValuations(ctx context.Context, in *ListValuationsRequest, opts ...grpc.CallOption) (*ListValuationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListValuationsResponse)
+	err := c.cc.Invoke(ctx, ValuationService_ListValuations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *valuationServiceClient) GetValuationAsOf(ctx context.Context, in *GetValuationAsOfRequest, opts ...grpc.CallOption) (*ValuationRecord, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValuationRecord)
+	err := c.cc.Invoke(ctx, ValuationService_GetValuationAsOf_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *valuationServiceClient) SimulateScenarios(ctx context.Context, in *ScenarioRequest, opts ...grpc.CallOption) (*ScenarioResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScenarioResponse)
+	err := c.cc.Invoke(ctx, ValuationService_SimulateScenarios_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *valuationServiceClient) ListPropertyTypes(ctx context.Context, in *ListPropertyTypesRequest, opts ...grpc.CallOption) (*ListPropertyTypesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPropertyTypesResponse)
+	err := c.cc.Invoke(ctx, ValuationService_ListPropertyTypes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *valuationServiceClient) ListConditions(ctx context.Context, in *ListConditionsRequest, opts ...grpc.CallOption) (*ListConditionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListConditionsResponse)
+	err := c.cc.Invoke(ctx, ValuationService_ListConditions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *valuationServiceClient) ListFeatures(ctx context.Context, in *ListFeaturesRequest, opts ...grpc.CallOption) (*ListFeaturesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFeaturesResponse)
+	err := c.cc.Invoke(ctx, ValuationService_ListFeatures_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *valuationServiceClient) ListLocationClasses(ctx context.Context, in *ListLocationClassesRequest, opts ...grpc.CallOption) (*ListLocationClassesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLocationClassesResponse)
+	err := c.cc.Invoke(ctx, ValuationService_ListLocationClasses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *valuationServiceClient) ReloadPricingModel(ctx context.Context, in *ReloadPricingModelRequest, opts ...grpc.CallOption) (*ReloadPricingModelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReloadPricingModelResponse)
+	err := c.cc.Invoke(ctx, ValuationService_ReloadPricingModel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *valuationServiceClient) ListUsage(ctx context.Context, in *ListUsageRequest, opts ...grpc.CallOption) (*ListUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUsageResponse)
+	err := c.cc.Invoke(ctx, ValuationService_ListUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *valuationServiceClient) UpsertTenantPricing(ctx context.Context, in *UpsertTenantPricingRequest, opts ...grpc.CallOption) (*TenantPricing, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantPricing)
+	err := c.cc.Invoke(ctx, ValuationService_UpsertTenantPricing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ValuationServiceServer is the server API for ValuationService service.
 // All implementations must embed UnimplementedValuationServiceServer
 // for forward compatibility.
-//
-// ValuationService provides methods for property valuation
 type ValuationServiceServer interface {
 	// CalculateValuation calculates the value of a property
 	CalculateValuation(context.Context, *ValuationRequest) (*ValuationResponse, error)
+	// CalculateSalesComparison values a property from recent comparable sales
+	CalculateSalesComparison(context.Context, *ValuationRequest) (*ValuationResponse, error)
+	// BatchCalculateValuation values every property of the batch concurrently;
+	// invalid items are reported individually without failing the batch
+	BatchCalculateValuation(context.Context, *BatchValuationRequest) (*BatchValuationResponse, error)
+	// StreamValuations values properties as they arrive and streams back each
+	// item as soon as it is ready, which may be out of request order
+	StreamValuations(grpc.BidiStreamingServer[ValuationRequest, ValuationItem]) error
+	// GetValuation returns a stored valuation by ID. Callers read the valuations of their tenant,
+	// administrators those of every tenant.
+	GetValuation(context.Context, *GetValuationRequest) (*ValuationRecord, error)
+	// ListValuations returns the stored valuations of an address within a time range made for the caller's tenant
+	ListValuations(context.Context, *ListValuationsRequest) (*ListValuationsResponse, error)
+	// GetValuationAsOf returns the latest valuation of an address made for the caller's tenant at or before a point in time
+	GetValuationAsOf(context.Context, *GetValuationAsOfRequest) (*ValuationRecord, error)
+	// SimulateScenarios values what-if modifications of a property and reports the
+	// value they add and their return on investment
+	SimulateScenarios(context.Context, *ScenarioRequest) (*ScenarioResponse, error)
+	// ListPropertyTypes returns the property types of the active pricing model
+	ListPropertyTypes(context.Context, *ListPropertyTypesRequest) (*ListPropertyTypesResponse, error)
+	// ListConditions returns the conditions of the active pricing model and their criteria
+	ListConditions(context.Context, *ListConditionsRequest) (*ListConditionsResponse, error)
+	// ListFeatures returns the features of the active pricing model and the value they add
+	ListFeatures(context.Context, *ListFeaturesRequest) (*ListFeaturesResponse, error)
+	// ListLocationClasses returns the location classes of the active pricing model
+	ListLocationClasses(context.Context, *ListLocationClassesRequest) (*ListLocationClassesResponse, error)
+	// ReloadPricingModel reloads the pricing model from its file; restricted to administrators
+	ReloadPricingModel(context.Context, *ReloadPricingModelRequest) (*ReloadPricingModelResponse, error)
+	// ListUsage returns the monthly usage counted for billing; restricted to administrators
+	ListUsage(context.Context, *ListUsageRequest) (*ListUsageResponse, error)
+	// UpsertTenantPricing creates or replaces the pricing overrides a tenant's valuations
+	// are made with; restricted to administrators
+	UpsertTenantPricing(context.Context, *UpsertTenantPricingRequest) (*TenantPricing, error)
 	mustEmbedUnimplementedValuationServiceServer()
 }
 
@@ -71,6 +290,48 @@ type UnimplementedValuationServiceServer struct{}
 func (UnimplementedValuationServiceServer) CalculateValuation(context.Context, *ValuationRequest) (*ValuationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CalculateValuation not implemented")
 }
+func (UnimplementedValuationServiceServer) CalculateSalesComparison(context.Context, *ValuationRequest) (*ValuationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculateSalesComparison not implemented")
+}
+func (UnimplementedValuationServiceServer) BatchCalculateValuation(context.Context, *BatchValuationRequest) (*BatchValuationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCalculateValuation not implemented")
+}
+func (UnimplementedValuationServiceServer) StreamValuations(grpc.BidiStreamingServer[ValuationRequest, ValuationItem]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamValuations not implemented")
+}
+func (UnimplementedValuationServiceServer) GetValuation(context.Context, *GetValuationRequest) (*ValuationRecord, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValuation not implemented")
+}
+func (UnimplementedValuationServiceServer) ListValuations(context.Context, *ListValuationsRequest) (*ListValuationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListValuations not implemented")
+}
+func (UnimplementedValuationServiceServer) GetValuationAsOf(context.Context, *GetValuationAsOfRequest) (*ValuationRecord, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValuationAsOf not implemented")
+}
+func (UnimplementedValuationServiceServer) SimulateScenarios(context.Context, *ScenarioRequest) (*ScenarioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateScenarios not implemented")
+}
+func (UnimplementedValuationServiceServer) ListPropertyTypes(context.Context, *ListPropertyTypesRequest) (*ListPropertyTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPropertyTypes not implemented")
+}
+func (UnimplementedValuationServiceServer) ListConditions(context.Context, *ListConditionsRequest) (*ListConditionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConditions not implemented")
+}
+func (UnimplementedValuationServiceServer) ListFeatures(context.Context, *ListFeaturesRequest) (*ListFeaturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFeatures not implemented")
+}
+func (UnimplementedValuationServiceServer) ListLocationClasses(context.Context, *ListLocationClassesRequest) (*ListLocationClassesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLocationClasses not implemented")
+}
+func (UnimplementedValuationServiceServer) ReloadPricingModel(context.Context, *ReloadPricingModelRequest) (*ReloadPricingModelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadPricingModel not implemented")
+}
+func (UnimplementedValuationServiceServer) ListUsage(context.Context, *ListUsageRequest) (*ListUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsage not implemented")
+}
+func (UnimplementedValuationServiceServer) UpsertTenantPricing(context.Context, *UpsertTenantPricingRequest) (*TenantPricing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertTenantPricing not implemented")
+}
 func (UnimplementedValuationServiceServer) mustEmbedUnimplementedValuationServiceServer() {}
 func (UnimplementedValuationServiceServer) testEmbeddedByValue()                          {}
 
@@ -110,6 +371,247 @@ func _ValuationService_CalculateValuation_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ValuationService_CalculateSalesComparison_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValuationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValuationServiceServer).CalculateSalesComparison(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ValuationService_CalculateSalesComparison_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValuationServiceServer).CalculateSalesComparison(ctx, req.(*ValuationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValuationService_BatchCalculateValuation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchValuationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValuationServiceServer).BatchCalculateValuation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ValuationService_BatchCalculateValuation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValuationServiceServer).BatchCalculateValuation(ctx, req.(*BatchValuationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValuationService_StreamValuations_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ValuationServiceServer).StreamValuations(&grpc.GenericServerStream[ValuationRequest, ValuationItem]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ValuationService_StreamValuationsServer = grpc.BidiStreamingServer[ValuationRequest, ValuationItem]
+
+func _ValuationService_GetValuation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValuationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValuationServiceServer).GetValuation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ValuationService_GetValuation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValuationServiceServer).GetValuation(ctx, req.(*GetValuationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValuationService_ListValuations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListValuationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValuationServiceServer).ListValuations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ValuationService_ListValuations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValuationServiceServer).ListValuations(ctx, req.(*ListValuationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValuationService_GetValuationAsOf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValuationAsOfRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValuationServiceServer).GetValuationAsOf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ValuationService_GetValuationAsOf_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValuationServiceServer).GetValuationAsOf(ctx, req.(*GetValuationAsOfRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValuationService_SimulateScenarios_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScenarioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValuationServiceServer).SimulateScenarios(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ValuationService_SimulateScenarios_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValuationServiceServer).SimulateScenarios(ctx, req.(*ScenarioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValuationService_ListPropertyTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPropertyTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValuationServiceServer).ListPropertyTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ValuationService_ListPropertyTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValuationServiceServer).ListPropertyTypes(ctx, req.(*ListPropertyTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValuationService_ListConditions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConditionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValuationServiceServer).ListConditions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ValuationService_ListConditions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValuationServiceServer).ListConditions(ctx, req.(*ListConditionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValuationService_ListFeatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFeaturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValuationServiceServer).ListFeatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ValuationService_ListFeatures_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValuationServiceServer).ListFeatures(ctx, req.(*ListFeaturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValuationService_ListLocationClasses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLocationClassesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValuationServiceServer).ListLocationClasses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ValuationService_ListLocationClasses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValuationServiceServer).ListLocationClasses(ctx, req.(*ListLocationClassesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValuationService_ReloadPricingModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadPricingModelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValuationServiceServer).ReloadPricingModel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ValuationService_ReloadPricingModel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValuationServiceServer).ReloadPricingModel(ctx, req.(*ReloadPricingModelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValuationService_ListUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValuationServiceServer).ListUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ValuationService_ListUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValuationServiceServer).ListUsage(ctx, req.(*ListUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValuationService_UpsertTenantPricing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertTenantPricingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValuationServiceServer).UpsertTenantPricing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ValuationService_UpsertTenantPricing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValuationServiceServer).UpsertTenantPricing(ctx, req.(*UpsertTenantPricingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ValuationService_ServiceDesc is the grpc.ServiceDesc for ValuationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -121,7 +623,66 @@ var ValuationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CalculateValuation",
 			Handler:    _ValuationService_CalculateValuation_Handler,
 		},
+		{
+			MethodName: "CalculateSalesComparison",
+			Handler:    _ValuationService_CalculateSalesComparison_Handler,
+		},
+		{
+			MethodName: "BatchCalculateValuation",
+			Handler:    _ValuationService_BatchCalculateValuation_Handler,
+		},
+		{
+			MethodName: "GetValuation",
+			Handler:    _ValuationService_GetValuation_Handler,
+		},
+		{
+			MethodName: "ListValuations",
+			Handler:    _ValuationService_ListValuations_Handler,
+		},
+		{
+			MethodName: "GetValuationAsOf",
+			Handler:    _ValuationService_GetValuationAsOf_Handler,
+		},
+		{
+			MethodName: "SimulateScenarios",
+			Handler:    _ValuationService_SimulateScenarios_Handler,
+		},
+		{
+			MethodName: "ListPropertyTypes",
+			Handler:    _ValuationService_ListPropertyTypes_Handler,
+		},
+		{
+			MethodName: "ListConditions",
+			Handler:    _ValuationService_ListConditions_Handler,
+		},
+		{
+			MethodName: "ListFeatures",
+			Handler:    _ValuationService_ListFeatures_Handler,
+		},
+		{
+			MethodName: "ListLocationClasses",
+			Handler:    _ValuationService_ListLocationClasses_Handler,
+		},
+		{
+			MethodName: "ReloadPricingModel",
+			Handler:    _ValuationService_ReloadPricingModel_Handler,
+		},
+		{
+			MethodName: "ListUsage",
+			Handler:    _ValuationService_ListUsage_Handler,
+		},
+		{
+			MethodName: "UpsertTenantPricing",
+			Handler:    _ValuationService_UpsertTenantPricing_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamValuations",
+			Handler:       _ValuationService_StreamValuations_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/valuation.proto",
 }